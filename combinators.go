@@ -0,0 +1,56 @@
+package jsonpath
+
+import (
+	"net/http"
+
+	httputil "github.com/steinfletcher/apitest-jsonpath/http"
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// Not asserts that the assertion fails, e.g. Not(Matches(`$.id`, `^tmp-`)). An assertion which cannot be evaluated,
+// e.g. because of an invalid pattern or a body that is not JSON, is reported rather than treated as failed
+func Not(assertion func(*http.Response, *http.Request) error) func(*http.Response, *http.Request) error {
+	return NotNamed("", assertion)
+}
+
+// NotNamed is like Not and names the expression in the failure message, e.g. NotNamed(`$.id`, Matches(`$.id`, `^tmp-`))
+func NotNamed(expression string, assertion func(*http.Response, *http.Request) error) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.Not(expression, bind(assertion, res, req))
+	}
+}
+
+// And asserts that every assertion passes and reports all of the failures
+func And(assertions ...func(*http.Response, *http.Request) error) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.And(bindAll(assertions, res, req))
+	}
+}
+
+// Or asserts that at least one of the assertions passes
+func Or(assertions ...func(*http.Response, *http.Request) error) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.Or(bindAll(assertions, res, req))
+	}
+}
+
+// AnyOf asserts that at least one of the assertions passes, e.g.
+// AnyOf(Equal(`$.status`, "active"), Equal(`$.status`, "pending")). It is equivalent to Or
+func AnyOf(assertions ...func(*http.Response, *http.Request) error) func(*http.Response, *http.Request) error {
+	return Or(assertions...)
+}
+
+// bind runs the assertion against copies of the response and request so that each assertion can read the body
+func bind(assertion func(*http.Response, *http.Request) error, res *http.Response, req *http.Request) func() error {
+	return func() error {
+		return assertion(httputil.CopyResponse(res), httputil.CopyRequest(req))
+	}
+}
+
+func bindAll(assertions []func(*http.Response, *http.Request) error, res *http.Response, req *http.Request) []func() error {
+	bound := make([]func() error, len(assertions))
+	for i, assertion := range assertions {
+		bound[i] = bind(assertion, res, req)
+	}
+	return bound
+}
@@ -0,0 +1,88 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+func TestApiTest_Combinators(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"id": "usr-1", "status": "pending", "age": 30}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.Not(jsonpath.Matches(`$.id`, `^tmp-`))).
+		Assert(jsonpath.Not(jsonpath.Equal(`$.deletedAt`, "2021-03-01"))).
+		Assert(jsonpath.Not(jsonpath.Matches(`$.missing`, `^tmp-`))).
+		Assert(jsonpath.AnyOf(jsonpath.Equal(`$.status`, "active"), jsonpath.Equal(`$.status`, "pending"))).
+		Assert(jsonpath.And(jsonpath.IsNumber(`$.age`), jsonpath.NumberBetween(`$.age`, 18, 65))).
+		Assert(jsonpath.Or(jsonpath.Not(jsonpath.Present(`$.id`)), jsonpath.HasPrefix(`$.id`, "usr-"))).
+		End()
+}
+
+func TestApiTest_Combinators_Failures(t *testing.T) {
+	body := `{"id": "tmp-1", "status": "deleted", "age": 12}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.Not(jsonpath.Matches(`$.id`, `^tmp-`)), `assertion passed but was expected to fail`},
+		{jsonpath.NotNamed(`$.id`, jsonpath.Matches(`$.id`, `^tmp-`)), `assertion on "$.id" passed but was expected to fail`},
+		{jsonpath.AnyOf(jsonpath.Equal(`$.status`, "active"), jsonpath.HasPrefix(`$.status`, "pend")), `none of 2 assertions passed:
+  - "$.status" not equal to expected value
+    expected: "active"
+    actual:   "deleted"
+    diff:
+      changed $.status: "active" -> "deleted"
+  - "$.status" value "deleted" does not start with "pend"`},
+		{jsonpath.And(jsonpath.IsString(`$.age`), jsonpath.IsNumber(`$.age`), jsonpath.NumberAtLeast(`$.age`, 18)), `2 of 3 assertions failed:
+  - "$.age" has type number, expected string
+  - "12" is not at least "18"`},
+		{jsonpath.Or(), `no assertions given`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
+
+func TestApiTest_Not_SetupErrors(t *testing.T) {
+	testCases := []struct {
+		body      string
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{`{"id": "usr-1"}`, jsonpath.Not(jsonpath.Matches(`$.id`, `^(tmp-`)), `invalid pattern: '^(tmp-'`},
+		{`{"id": `, jsonpath.Not(jsonpath.Matches(`$.id`, `^tmp-`)), `unexpected end of JSON input`},
+		{`{"id": "usr-1"}`, jsonpath.Not(jsonpath.Matches(`$..[`, `^tmp-`)), "evaluating '$..[' resulted in error: 'parsing error: $..[\t:1:5 - 1:5 unexpected EOF while scanning extensions'"},
+		{`{"id": "usr-1"}`, jsonpath.Not(jsonpath.Equal(`$.name[`, "tmp")), "evaluating '$.name[' resulted in error: 'parsing error: $.name[\t:1:8 - 1:8 unexpected EOF while scanning extensions'"},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(testCase.body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
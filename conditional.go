@@ -0,0 +1,63 @@
+package jsonpath
+
+import (
+	"errors"
+	"net/http"
+
+	httputil "github.com/steinfletcher/apitest-jsonpath/http"
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// Condition is a condition started with When. Call Then to add the assertions that run when it passes
+type Condition struct {
+	chain     *AssertionChain
+	condition func(*http.Response, *http.Request) error
+}
+
+// conditional runs then when the condition passes and otherwise, if set, when it fails
+type conditional struct {
+	condition func(*http.Response, *http.Request) error
+	then      *AssertionChain
+	otherwise *AssertionChain
+}
+
+// When starts a conditional assertion. The condition is any assertion, e.g. Equal(`$.type`, "card"), and its failure
+// only selects which chain runs. A condition which cannot be evaluated, e.g. because of an invalid pattern or a body
+// that is not JSON, fails the chain
+func (r *AssertionChain) When(condition func(*http.Response, *http.Request) error) *Condition {
+	return &Condition{chain: r, condition: condition}
+}
+
+// Then adds the chain to run when the condition passes and returns the chain the condition was started on
+func (c *Condition) Then(chain *AssertionChain) *AssertionChain {
+	cond := &conditional{condition: c.condition, then: chain}
+	c.chain.lastCondition = cond
+	return c.chain.add("", cond.run)
+}
+
+// Otherwise sets the chain to run when the condition of the last When fails
+func (r *AssertionChain) Otherwise(chain *AssertionChain) *AssertionChain {
+	if r.lastCondition == nil {
+		return r.add("", func(*http.Response, *http.Request) error {
+			return errors.New("no When(...).Then(...) for Otherwise to attach to")
+		})
+	}
+	r.lastCondition.otherwise = chain
+	r.lastCondition = nil
+	return r
+}
+
+func (c *conditional) run(res *http.Response, req *http.Request) error {
+	err := c.condition(httputil.CopyResponse(res), httputil.CopyRequest(req))
+	if err == nil {
+		return c.then.End()(res, req)
+	}
+	var setupErr *jsonpath.SetupError
+	if errors.As(err, &setupErr) {
+		return err
+	}
+	if c.otherwise != nil {
+		return c.otherwise.End()(res, req)
+	}
+	return nil
+}
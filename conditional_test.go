@@ -0,0 +1,82 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+func paymentChain() func(*http.Response, *http.Request) error {
+	return jsonpath.Chain().
+		Present(`$.id`).
+		When(jsonpath.Equal(`$.payment.type`, "card")).
+		Then(jsonpath.Root("$.payment").
+			Matches("last4", `^\d{4}$`).
+			NotExists("iban")).
+		Otherwise(jsonpath.Root("$.payment").
+			Equal("type", "bank").
+			Present("iban")).
+		End()
+}
+
+func TestApiTest_When(t *testing.T) {
+	for _, body := range []string{
+		`{"id": "1", "payment": {"type": "card", "last4": "4242"}}`,
+		`{"id": "2", "payment": {"type": "bank", "iban": "DE89370400440532013000"}}`,
+	} {
+		body := body
+		handler := http.NewServeMux()
+		handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(body))
+			if err != nil {
+				panic(err)
+			}
+		})
+
+		apitest.New().
+			Handler(handler).
+			Get("/hello").
+			Expect(t).
+			Assert(paymentChain()).
+			Assert(jsonpath.Chain().
+				When(jsonpath.Equal(`$.payment.type`, "cash")).
+				Then(jsonpath.Chain().Present(`$.change`)).
+				End()).
+			End()
+	}
+}
+
+func TestApiTest_When_Failures(t *testing.T) {
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		body      string
+		expected  string
+	}{
+		{paymentChain(), `{"id": "1", "payment": {"type": "card", "last4": "42x2"}}`, `value '42x2' does not match pattern '^\d{4}$'`},
+		{paymentChain(), `{"id": "2", "payment": {"type": "bank"}}`, `value not present for expression: '$.payment.iban'`},
+		{paymentChain(), `{"id": "3", "payment": {"type": "cash"}}`, `"$.payment.type" not equal to expected value
+  expected: "bank"
+  actual:   "cash"
+  diff:
+    changed $.payment.type: "bank" -> "cash"`},
+		{jsonpath.Chain().Otherwise(jsonpath.Chain()).End(), `{}`, `no When(...).Then(...) for Otherwise to attach to`},
+		{jsonpath.Chain().When(jsonpath.Matches(`$.type`, `^(card`)).Then(jsonpath.Chain().Present(`$.last4`)).End(), `{"type": "card"}`, `invalid pattern: '^(card'`},
+		{jsonpath.Chain().When(jsonpath.Equal(`$.type`, "card")).Then(jsonpath.Chain().Present(`$.last4`)).End(), `{"type": `, `unexpected end of JSON input`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(testCase.body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
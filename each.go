@@ -0,0 +1,74 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	httputil "github.com/steinfletcher/apitest-jsonpath/http"
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// Each makes the chain run once for every element selected by its root expression, e.g. Root("$.items[*]").Each().
+// Expressions of assertions added after Each are relative to the element. Each must be called on a chain with a root
+// expression and before any assertion is added, otherwise the chain fails
+func (r *AssertionChain) Each() *AssertionChain {
+	switch {
+	case r.rootExpression == "":
+		return r.fail(errors.New("Each must be called on a chain with a root expression, e.g. Root(\"$.items\").Each()"))
+	case len(r.assertions) > 0:
+		return r.fail(fmt.Errorf("Each must be called on \"%s\" before any assertion is added to the chain", r.rootExpression))
+	}
+	r.eachExpression = r.rootExpression
+	r.rootExpression = "$"
+	return r
+}
+
+// fail makes the chain fail with err before any of its assertions run
+func (r *AssertionChain) fail(err error) *AssertionChain {
+	r.assertions = append([]chainAssertion{{assertion: func(*http.Response, *http.Request) error {
+		return err
+	}}}, r.assertions...)
+	return r
+}
+
+// ForEach runs the assertions of chain against every element selected by the expression. The expressions of chain are
+// relative to the element and failures are reported with the resolved path, e.g. `$.items[3].price: ...`
+func (r *AssertionChain) ForEach(expression string, chain *AssertionChain) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, forEach(expression, chain))
+}
+
+func forEach(expression string, chain *AssertionChain) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		elements, err := jsonpath.Elements(expression, httputil.CopyResponse(res).Body)
+		if err != nil {
+			return err
+		}
+
+		var failures []string
+		failed := 0
+		for _, element := range elements {
+			body, err := json.Marshal(element.Value)
+			if err != nil {
+				return err
+			}
+
+			elementFailures := chain.failures(httputil.ResponseWithBody(res, body), req)
+			if len(elementFailures) > 0 {
+				failed++
+			}
+			for _, failure := range elementFailures {
+				message := strings.Replace(failure.err.Error(), "\n", "\n  ", -1)
+				failures = append(failures, "  "+jsonpath.JoinPath(element.Path, failure.expression)+": "+message)
+			}
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("%d of %d elements of \"%s\" failed:\n%s", failed, len(elements), expression, strings.Join(failures, "\n"))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,90 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+func TestApiTest_Each_ForEach(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"order": {"items": [{"sku": "a-1", "price": 3.99, "tags": ["new"]}, {"sku": "b-2", "price": 10, "tags": []}]}}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.Root("$.order.items[*]").Each().
+			NumberGreaterThan("price", 0).
+			HasPrefix("$.sku", "").
+			IsArray("tags").
+			End()).
+		Assert(jsonpath.Root("$.order").
+			ForEach("items", jsonpath.Chain().
+				IsString("sku").
+				MatchesAll("$.tags", `^[a-z]+$`)).
+			End()).
+		Assert(jsonpath.Root("$.order").Array("items", func(c *jsonpath.AssertionChain) {
+			c.Each().IsString("sku").NumberGreaterThan("price", 0)
+		}).End()).
+		End()
+}
+
+func TestApiTest_Each_ForEach_Failures(t *testing.T) {
+	body := `{"items": [{"sku": "a-1", "price": 3.99}, {"sku": 2, "price": 0}, {"sku": "c-3", "price": -1}, {"sku": "d-4", "price": 5}], "id": 1}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.Root("$.items[*]").Each().
+			IsString("sku").
+			NumberGreaterThan("price", 0).
+			End(), `2 of 4 elements of "$.items[*]" failed:
+  $.items[1].sku: "$.sku" has type number, expected string
+  $.items[2].price: "-1" is not greater than "0"`},
+		{jsonpath.Root("$.items").Each().
+			Soft().
+			IsString("sku").
+			NumberGreaterThan("price", 0).
+			End(), `2 of 4 elements of "$.items" failed:
+  $.items[1].sku: "$.sku" has type number, expected string
+  $.items[1].price: "0" is not greater than "0"
+  $.items[2].price: "-1" is not greater than "0"`},
+		{jsonpath.Chain().ForEach("$.items", jsonpath.Chain().NotEqual("sku", "c-3").IsString("sku")).End(), `2 of 4 elements of "$.items" failed:
+  $.items[1].sku: "sku" has type number, expected string
+  $.items[2].sku: "sku" value is equal to "c-3"`},
+		{jsonpath.Root("$.id").Each().IsString("sku").End(), `"$.id" has type number, expected array`},
+		{jsonpath.Root("$.items[*]").Equal("sku", "a-1").Each().IsString("sku").End(), `Each must be called on "$.items[*]" before any assertion is added to the chain`},
+		{jsonpath.Chain().Each().IsString("sku").End(), `Each must be called on a chain with a root expression, e.g. Root("$.items").Each()`},
+		{jsonpath.Root("$").Array("items", func(c *jsonpath.AssertionChain) {
+			c.Each().IsString("sku")
+		}).End(), `1 of 4 elements of "$.items" failed:
+  $.items[1].sku: "$.sku" has type number, expected string`},
+		{jsonpath.Root("$").Object("items[0]", func(c *jsonpath.AssertionChain) {
+			c.Soft().IsNumber("sku").IsString("price")
+		}).End(), `2 of 2 assertions failed:
+  "$.items[0].sku" has type string, expected number
+  "$.items[0].price" has type number, expected string`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
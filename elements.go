@@ -0,0 +1,44 @@
+package jsonpath
+
+import (
+	"net/http"
+
+	httputil "github.com/steinfletcher/apitest-jsonpath/http"
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// All asserts that the assertion passes for every element selected by the expression, e.g. `$.items[*]` or `$.items`.
+// The assertion is evaluated against each element, so its expressions are relative to the element, e.g. `$.price`
+func All(expression string, assertion func(*http.Response, *http.Request) error) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.All(expression, elementAssertion(assertion, res, req), res.Body)
+	}
+}
+
+// Any asserts that the assertion passes for at least one element selected by the expression
+func Any(expression string, assertion func(*http.Response, *http.Request) error) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.Any(expression, elementAssertion(assertion, res, req), res.Body)
+	}
+}
+
+// None asserts that the assertion fails for every element selected by the expression
+func None(expression string, assertion func(*http.Response, *http.Request) error) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.None(expression, elementAssertion(assertion, res, req), res.Body)
+	}
+}
+
+// ElementsMatch asserts that the elements selected by the expression and the expected elements are the same, ignoring
+// their order. Each element must appear the same number of times in both
+func ElementsMatch(expression string, expected interface{}) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.ElementsMatch(expression, expected, res.Body)
+	}
+}
+
+func elementAssertion(assertion func(*http.Response, *http.Request) error, res *http.Response, req *http.Request) func([]byte) error {
+	return func(element []byte) error {
+		return assertion(httputil.ResponseWithBody(res, element), httputil.CopyRequest(req))
+	}
+}
@@ -0,0 +1,149 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+func TestApiTest_All_Any_None(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"items": [{"sku": "a-1", "price": 3.99}, {"sku": "b-2", "price": 10}], "tags": ["a", "b"]}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.All(`$.items[*]`, jsonpath.Chain().
+			NumberGreaterThan("$.price", 0).
+			NotEmpty("$.sku").
+			End())).
+		Assert(jsonpath.All(`$.items`, jsonpath.IsObject(`$`))).
+		Assert(jsonpath.Any(`$.items[*]`, jsonpath.Equal(`$.sku`, "b-2"))).
+		Assert(jsonpath.None(`$.items[*]`, jsonpath.NumberGreaterThan(`$.price`, 100))).
+		Assert(jsonpath.All(`$.tags[*]`, jsonpath.Matches(`$`, `^[a-z]$`))).
+		Assert(jsonpath.Chain().All("$.items[*]", jsonpath.IsString("$.sku")).End()).
+		End()
+}
+
+func TestApiTest_All_Failures(t *testing.T) {
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.All(`$.items[*]`, jsonpath.Chain().NumberGreaterThan("$.price", 0).NotEmpty("$.sku").End()), `1 of 3 elements of "$.items[*]" failed:
+  $.items[1]: "0" is not greater than "0"`},
+		{jsonpath.All(`$.items`, jsonpath.Equal(`$.price`, 10)), `2 of 3 elements of "$.items" failed:
+  $.items[0]: "$.price" not equal to expected value
+    expected: 10
+    actual:   3.99
+    diff:
+      changed $.price: 10 -> 3.99
+  $.items[1]: "$.price" not equal to expected value
+    expected: 10
+    actual:   0
+    diff:
+      changed $.price: 10 -> 0`},
+		{jsonpath.Any(`$.items[*]`, jsonpath.Equal(`$.sku`, "x")), `no elements of "$.items[*]" passed:
+  $.items[0]: "$.sku" not equal to expected value
+    expected: "x"
+    actual:   "a-1"
+    diff:
+      changed $.sku: "x" -> "a-1"
+  $.items[1]: "$.sku" not equal to expected value
+    expected: "x"
+    actual:   ""
+    diff:
+      changed $.sku: "x" -> ""
+  $.items[2]: "$.sku" not equal to expected value
+    expected: "x"
+    actual:   "c-3"
+    diff:
+      changed $.sku: "x" -> "c-3"`},
+		{jsonpath.Any(`$.empty[*]`, jsonpath.IsString(`$`)), `no elements of "$.empty[*]" passed: "$.empty[*]" is empty`},
+		{jsonpath.None(`$.items[?(@.price < 5)]`, jsonpath.IsNumber(`$.price`)), `2 of 2 elements of "$.items[?(@.price < 5)]" passed, expected none: $.items[0], $.items[1]`},
+		{jsonpath.All(`$.items[0]`, jsonpath.IsString(`$`)), `"$.items[0]" has type object, expected array`},
+		{jsonpath.All(`$..price`, jsonpath.NumberGreaterThan(`$`, 5)), `2 of 3 elements of "$..price" failed:
+  $.items[0].price: "3.99" is not greater than "5"
+  $.items[1].price: "0" is not greater than "5"`},
+		{jsonpath.None(`$..[0]`, jsonpath.IsNumber(`$.price`)), `1 of 1 elements of "$..[0]" passed, expected none: $.items[0]`},
+		{jsonpath.All(`$..["sku"]`, jsonpath.HasPrefix(`$`, "c")), `2 of 3 elements of "$..["sku"]" failed:
+  $.items[0].sku: "$" value "a-1" does not start with "c"
+  $.items[1].sku: "$" value "" does not start with "c"`},
+		{jsonpath.All(`$.items[?(@.price > 1)]["sku", "price"]`, jsonpath.IsString(`$`)), `2 of 4 elements of "$.items[?(@.price > 1)]["sku", "price"]" failed:
+  $.items[0].price: "$" has type number, expected string
+  $.items[2].price: "$" has type number, expected string`},
+		{jsonpath.None(`$.items[1:]["sku", "price"]`, jsonpath.IsString(`$`)), `2 of 4 elements of "$.items[1:]["sku", "price"]" passed, expected none: $.items[1].sku, $.items[2].sku`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(`{"empty": [], "items": [{"sku": "a-1", "price": 3.99}, {"sku": "", "price": 0}, {"sku": "c-3", "price": 10}]}`))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
+
+func TestApiTest_ElementsMatch(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"ids": [3, 1, 2, 1], "prices": [0.1, 19.99, 0.2], "users": [{"name": "sue", "age": 30}, {"name": "jon", "age": 20}]}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.ElementsMatch(`$.ids`, []int{1, 1, 2, 3})).
+		Assert(jsonpath.ElementsMatch(`$.prices`, []float64{0.2, 0.1, 19.99})).
+		Assert(jsonpath.ElementsMatch(`$.prices[*]`, []float32{19.99, 0.2, 0.1})).
+		Assert(jsonpath.ElementsMatch(`$.users[*].name`, []string{"jon", "sue"})).
+		Assert(jsonpath.ElementsMatch(`$.users`, []map[string]interface{}{{"name": "jon", "age": 20}, {"name": "sue", "age": 30}})).
+		Assert(jsonpath.Root("$").ElementsMatch("ids", []float64{1, 2, 3, 1}).End()).
+		End()
+}
+
+func TestApiTest_ElementsMatch_Failures(t *testing.T) {
+	body := `{"ids": [3, 1, 2, 1], "id": 1}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.ElementsMatch(`$.ids`, []int{1, 2, 3}), `"$.ids" elements do not match expected elements
+  extra:   [1]`},
+		{jsonpath.ElementsMatch(`$.ids`, []int{1, 2, 3, 1, 4}), `"$.ids" elements do not match expected elements
+  missing: [4]`},
+		{jsonpath.ElementsMatch(`$.ids`, []int{1, 2, 4, 5}), `"$.ids" elements do not match expected elements
+  missing: [4,5]
+  extra:   [3,1]`},
+		{jsonpath.ElementsMatch(`$.ids`, 1), `expected value has type number, expected array`},
+		{jsonpath.ElementsMatch(`$.id`, []int{1}), `"$.id" has type number, expected array`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
@@ -0,0 +1,21 @@
+package jsonpath
+
+import (
+	"net/http"
+
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// Format asserts that the string selected by the expression, or every string selected by a wildcard expression, is
+// valid for the format. The formats of the JSON Schema vocabulary are built in, e.g. uuid, email, uri, ipv4, ipv6,
+// date, date-time and hostname, together with base64
+func Format(expression string, format string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.Format(expression, format, res.Body)
+	}
+}
+
+// RegisterFormat registers a custom format for use with Format, replacing any format already registered with the name
+func RegisterFormat(name string, validate func(value string) bool) {
+	jsonpath.RegisterFormat(name, validate)
+}
@@ -0,0 +1,90 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+func TestApiTest_Format(t *testing.T) {
+	jsonpath.RegisterFormat("sku", func(value string) bool {
+		return strings.HasPrefix(value, "sku-")
+	})
+
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{
+			"id": "7d444840-9dc0-11d1-b245-5ffdce74fad2",
+			"email": "jon@example.com",
+			"website": "https://example.com/jon?tab=1",
+			"addresses": ["192.168.0.1", "10.0.0.1"],
+			"ipv6": "2001:db8::1",
+			"birthday": "1990-05-17",
+			"createdAt": "2021-03-01T10:00:00Z",
+			"host": "api.example.com",
+			"avatar": "aGVsbG8=",
+			"items": [{"sku": "sku-1"}, {"sku": "sku-2"}]
+		}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.Format(`$.id`, "uuid")).
+		Assert(jsonpath.Format(`$.email`, "email")).
+		Assert(jsonpath.Format(`$.website`, "uri")).
+		Assert(jsonpath.Format(`$.addresses[*]`, "ipv4")).
+		Assert(jsonpath.Format(`$.ipv6`, "ipv6")).
+		Assert(jsonpath.Format(`$.birthday`, "date")).
+		Assert(jsonpath.Format(`$.createdAt`, "date-time")).
+		Assert(jsonpath.Format(`$.host`, "hostname")).
+		Assert(jsonpath.Format(`$.avatar`, "base64")).
+		Assert(jsonpath.Format(`$.items[*].sku`, "sku")).
+		Assert(jsonpath.Root("$").Format("id", "uuid").Format("items[*].sku", "sku").End()).
+		End()
+}
+
+func TestApiTest_Format_Failures(t *testing.T) {
+	body := `{"id": "7d444840-9dc0-11d1", "email": "jon", "ids": ["7d444840-9dc0-11d1-b245-5ffdce74fad2", "abc", 1], "birthday": "1990-02-30", "avatar": "aGVsbG8"}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.Format(`$.id`, "uuid"), `"$.id" is not a valid uuid:
+  $.id: "7d444840-9dc0-11d1"`},
+		{jsonpath.Format(`$.email`, "email"), `"$.email" is not a valid email:
+  $.email: "jon"`},
+		{jsonpath.Format(`$.ids[*]`, "uuid"), `"$.ids[*]" is not a valid uuid:
+  $.ids[1]: "abc"
+  $.ids[2]: has type number, expected string`},
+		{jsonpath.Format(`$.birthday`, "date"), `"$.birthday" is not a valid date:
+  $.birthday: "1990-02-30"`},
+		{jsonpath.Format(`$.avatar`, "base64"), `"$.avatar" is not a valid base64:
+  $.avatar: "aGVsbG8"`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+
+	err := jsonpath.Format(`$.id`, "unknown")(&http.Response{Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body)))}, nil)
+	assert.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "unknown format 'unknown', expected one of base64, date, date-time,"), err.Error())
+}
@@ -3,7 +3,10 @@ module github.com/steinfletcher/apitest-jsonpath
 go 1.13
 
 require (
+	github.com/PaesslerAG/gval v1.0.0
 	github.com/PaesslerAG/jsonpath v0.1.1
+	github.com/santhosh-tekuri/jsonschema/v5 v5.3.0
 	github.com/steinfletcher/apitest v1.5.10
 	github.com/stretchr/testify v1.7.0
+	golang.org/x/text v0.13.0
 )
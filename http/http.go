@@ -37,6 +37,10 @@ func CopyResponse(response *http.Response) *http.Response {
 }
 
 func CopyRequest(request *http.Request) *http.Request {
+	if request == nil {
+		return nil
+	}
+
 	resCopy := &http.Request{
 		Method:        request.Method,
 		Host:          request.Host,
@@ -70,3 +74,23 @@ func CopyRequest(request *http.Request) *http.Request {
 
 	return resCopy
 }
+
+func ResponseWithBody(response *http.Response, body []byte) *http.Response {
+	resCopy := CopyResponse(response)
+	if resCopy == nil {
+		resCopy = &http.Response{Header: map[string][]string{}}
+	}
+	resCopy.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+	resCopy.ContentLength = int64(len(body))
+	return resCopy
+}
+
+func RequestWithBody(request *http.Request, body []byte) *http.Request {
+	reqCopy := CopyRequest(request)
+	if reqCopy == nil {
+		reqCopy = &http.Request{Header: make(http.Header)}
+	}
+	reqCopy.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+	reqCopy.ContentLength = int64(len(body))
+	return reqCopy
+}
@@ -3,14 +3,15 @@ package jsonpath
 import (
 	"fmt"
 	"net/http"
-	"reflect"
-	regex "regexp"
+	"strings"
+	"time"
 
 	httputil "github.com/steinfletcher/apitest-jsonpath/http"
 	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
 )
 
-// Contains is a convenience function to assert that a jsonpath expression extracts a value in an array
+// Contains is a convenience function to assert that a jsonpath expression extracts a value in an array. When the
+// expression extracts a string it asserts that the string contains expected, use ContainsString to make this explicit
 func Contains(expression string, expected interface{}) func(*http.Response, *http.Request) error {
 	return func(res *http.Response, req *http.Request) error {
 		return jsonpath.Contains(expression, expected, res.Body)
@@ -31,6 +32,28 @@ func NotEqual(expression string, expected interface{}) func(*http.Response, *htt
 	}
 }
 
+// EqualIgnoring asserts that the value equals the expected value once the values selected by the ignore paths
+// are removed from both. Ignore paths are jsonpath expressions relative to the value, e.g. `$..createdAt`
+func EqualIgnoring(expression string, expected interface{}, ignorePaths ...string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.EqualIgnoring(expression, expected, ignorePaths, res.Body)
+	}
+}
+
+// EqualSubset asserts that the value matches the expected object or array, ignoring keys that are not in the expected value
+func EqualSubset(expression string, expected interface{}) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.EqualSubset(expression, expected, res.Body)
+	}
+}
+
+// ContainsSubset asserts that the array contains an element which matches the expected value, ignoring keys that are not in the expected value
+func ContainsSubset(expression string, expected interface{}) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.ContainsSubset(expression, expected, res.Body)
+	}
+}
+
 // Len asserts that value is the expected length, determined by reflect.Len
 func Len(expression string, expectedLength int) func(*http.Response, *http.Request) error {
 	return func(res *http.Response, req *http.Request) error {
@@ -52,55 +75,151 @@ func LessThan(expression string, maximumLength int) func(*http.Response, *http.R
 	}
 }
 
-// Present asserts that value returned by the expression is present
+// NumberGreaterThan asserts that the numeric value is greater than the given bound
+func NumberGreaterThan(expression string, bound float64) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.NumberGreaterThan(expression, bound, res.Body)
+	}
+}
+
+// NumberLessThan asserts that the numeric value is less than the given bound
+func NumberLessThan(expression string, bound float64) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.NumberLessThan(expression, bound, res.Body)
+	}
+}
+
+// NumberAtLeast asserts that the numeric value is greater than or equal to the given bound
+func NumberAtLeast(expression string, bound float64) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.NumberAtLeast(expression, bound, res.Body)
+	}
+}
+
+// NumberAtMost asserts that the numeric value is less than or equal to the given bound
+func NumberAtMost(expression string, bound float64) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.NumberAtMost(expression, bound, res.Body)
+	}
+}
+
+// NumberBetween asserts that the numeric value is within the given inclusive range
+func NumberBetween(expression string, min, max float64) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.NumberBetween(expression, min, max, res.Body)
+	}
+}
+
+// IsString asserts that the value returned by the expression is a string
+func IsString(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.IsString(expression, res.Body)
+	}
+}
+
+// IsNumber asserts that the value returned by the expression is a number
+func IsNumber(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.IsNumber(expression, res.Body)
+	}
+}
+
+// IsInteger asserts that the value returned by the expression is a number without a fractional part
+func IsInteger(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.IsInteger(expression, res.Body)
+	}
+}
+
+// IsBool asserts that the value returned by the expression is a boolean
+func IsBool(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.IsBool(expression, res.Body)
+	}
+}
+
+// IsArray asserts that the value returned by the expression is an array
+func IsArray(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.IsArray(expression, res.Body)
+	}
+}
+
+// IsObject asserts that the value returned by the expression is an object
+func IsObject(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.IsObject(expression, res.Body)
+	}
+}
+
+// IsNull asserts that the value returned by the expression is null
+func IsNull(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.IsNull(expression, res.Body)
+	}
+}
+
+// Satisfies asserts that the value returned by the expression satisfies a custom predicate. The predicate receives the
+// decoded value and returns an error describing why it does not match
+func Satisfies(expression string, predicate func(value interface{}) error) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.Satisfies(expression, predicate, res.Body)
+	}
+}
+
+// Present asserts that value returned by the expression is present. Null and zero values such as 0, false and ""
+// are treated as not present, use Exists, NotNull or NotEmpty to tell them apart
 func Present(expression string) func(*http.Response, *http.Request) error {
 	return func(res *http.Response, req *http.Request) error {
 		return jsonpath.Present(expression, res.Body)
 	}
 }
 
-// NotPresent asserts that value returned by the expression is not present
+// NotPresent asserts that value returned by the expression is not present. Null and zero values such as 0, false and ""
+// are treated as not present, use NotExists to assert that a key is missing
 func NotPresent(expression string) func(*http.Response, *http.Request) error {
 	return func(res *http.Response, req *http.Request) error {
 		return jsonpath.NotPresent(expression, res.Body)
 	}
 }
 
-// Matches asserts that the value matches the given regular expression
+// Exists asserts that the expression selects a value, which may be null or a zero value such as 0, false or ""
+func Exists(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.Exists(expression, res.Body)
+	}
+}
+
+// NotExists asserts that the expression does not select a value, e.g. the key is missing
+func NotExists(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.NotExists(expression, res.Body)
+	}
+}
+
+// NotNull asserts that the expression selects a value that is not null
+func NotNull(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.NotNull(expression, res.Body)
+	}
+}
+
+// NotEmpty asserts that the expression selects a value that is not null, "", [] or {}. Zero values such as 0 and false are not empty
+func NotEmpty(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.NotEmpty(expression, res.Body)
+	}
+}
+
+// Matches asserts that the value matches the given regular expression. The pattern is compiled when the assertion is
+// created
 func Matches(expression string, regexp string) func(*http.Response, *http.Request) error {
+	pattern, err := jsonpath.CompilePattern(regexp)
 	return func(res *http.Response, req *http.Request) error {
-		pattern, err := regex.Compile(regexp)
 		if err != nil {
-			return fmt.Errorf("invalid pattern: '%s'", regexp)
-		}
-		value, _ := jsonpath.JsonPath(res.Body, expression)
-		if value == nil {
-			return fmt.Errorf("no match for pattern: '%s'", expression)
-		}
-		kind := reflect.ValueOf(value).Kind()
-		switch kind {
-		case reflect.Bool,
-			reflect.Int,
-			reflect.Int8,
-			reflect.Int16,
-			reflect.Int32,
-			reflect.Int64,
-			reflect.Uint,
-			reflect.Uint8,
-			reflect.Uint16,
-			reflect.Uint32,
-			reflect.Uint64,
-			reflect.Uintptr,
-			reflect.Float32,
-			reflect.Float64,
-			reflect.String:
-			if !pattern.Match([]byte(fmt.Sprintf("%v", value))) {
-				return fmt.Errorf("value '%v' does not match pattern '%v'", value, regexp)
-			}
-			return nil
-		default:
-			return fmt.Errorf("unable to match using type: %s", kind.String())
+			return err
 		}
+		return jsonpath.Matches(expression, pattern, res.Body)
 	}
 }
 
@@ -109,61 +228,500 @@ func Chain() *AssertionChain {
 	return &AssertionChain{rootExpression: ""}
 }
 
-// Root creates a new assertion chain prefixed with the given expression
+// Root creates a new assertion chain whose expressions are relative to the given expression. Child expressions may
+// use names, bracket notation or `$` for the root, e.g. Root("$.order").Equal("['x-id']", "1")
 func Root(expression string) *AssertionChain {
-	return &AssertionChain{rootExpression: expression + "."}
+	return &AssertionChain{rootExpression: expression}
 }
 
 // AssertionChain supports chaining assertions and root expressions
 type AssertionChain struct {
 	rootExpression string
-	assertions     []func(*http.Response, *http.Request) error
+	eachExpression string
+	assertions     []chainAssertion
+	soft           bool
+	lastCondition  *conditional
+}
+
+// chainAssertion is an assertion of the chain together with the expression it was added with
+type chainAssertion struct {
+	expression string
+	assertion  func(*http.Response, *http.Request) error
+}
+
+// Object asserts that the expression selects an object and adds the assertions made by build, whose expressions are
+// relative to that object
+func (r *AssertionChain) Object(expression string, build func(c *AssertionChain)) *AssertionChain {
+	return r.scope(expression, IsObject, build)
+}
+
+// Array asserts that the expression selects an array and adds the assertions made by build, whose expressions are
+// relative to that array, e.g. `[0].name`
+func (r *AssertionChain) Array(expression string, build func(c *AssertionChain)) *AssertionChain {
+	return r.scope(expression, IsArray, build)
+}
+
+func (r *AssertionChain) scope(expression string, typeAssertion func(string) func(*http.Response, *http.Request) error, build func(c *AssertionChain)) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	r.add(expression, typeAssertion(expression))
+
+	nested := &AssertionChain{rootExpression: expression}
+	build(nested)
+	if nested.eachExpression != "" || nested.soft && !r.soft {
+		return r.add(expression, nested.End())
+	}
+	r.assertions = append(r.assertions, nested.assertions...)
+	return r
+}
+
+// Soft makes End run every assertion and report all of the failures instead of stopping at the first one
+func (r *AssertionChain) Soft() *AssertionChain {
+	r.soft = true
+	return r
+}
+
+func (r *AssertionChain) add(expression string, assertion func(*http.Response, *http.Request) error) *AssertionChain {
+	r.assertions = append(r.assertions, chainAssertion{expression: expression, assertion: assertion})
+	return r
 }
 
 // Equal adds an Equal assertion to the chain
 func (r *AssertionChain) Equal(expression string, expected interface{}) *AssertionChain {
-	r.assertions = append(r.assertions, Equal(r.rootExpression+expression, expected))
-	return r
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, Equal(expression, expected))
 }
 
 // NotEqual adds an NotEqual assertion to the chain
 func (r *AssertionChain) NotEqual(expression string, expected interface{}) *AssertionChain {
-	r.assertions = append(r.assertions, NotEqual(r.rootExpression+expression, expected))
-	return r
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, NotEqual(expression, expected))
 }
 
 // Contains adds an Contains assertion to the chain
 func (r *AssertionChain) Contains(expression string, expected interface{}) *AssertionChain {
-	r.assertions = append(r.assertions, Contains(r.rootExpression+expression, expected))
-	return r
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, Contains(expression, expected))
+}
+
+// Len adds an Len assertion to the chain
+func (r *AssertionChain) Len(expression string, expectedLength int) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, Len(expression, expectedLength))
+}
+
+// GreaterThan adds an GreaterThan assertion to the chain
+func (r *AssertionChain) GreaterThan(expression string, minimumLength int) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, GreaterThan(expression, minimumLength))
+}
+
+// LessThan adds an LessThan assertion to the chain
+func (r *AssertionChain) LessThan(expression string, maximumLength int) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, LessThan(expression, maximumLength))
+}
+
+// NumberGreaterThan adds an NumberGreaterThan assertion to the chain
+func (r *AssertionChain) NumberGreaterThan(expression string, bound float64) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, NumberGreaterThan(expression, bound))
+}
+
+// NumberLessThan adds an NumberLessThan assertion to the chain
+func (r *AssertionChain) NumberLessThan(expression string, bound float64) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, NumberLessThan(expression, bound))
+}
+
+// NumberAtLeast adds an NumberAtLeast assertion to the chain
+func (r *AssertionChain) NumberAtLeast(expression string, bound float64) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, NumberAtLeast(expression, bound))
+}
+
+// NumberAtMost adds an NumberAtMost assertion to the chain
+func (r *AssertionChain) NumberAtMost(expression string, bound float64) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, NumberAtMost(expression, bound))
+}
+
+// NumberBetween adds an NumberBetween assertion to the chain
+func (r *AssertionChain) NumberBetween(expression string, min, max float64) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, NumberBetween(expression, min, max))
+}
+
+// IsString adds an IsString assertion to the chain
+func (r *AssertionChain) IsString(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, IsString(expression))
+}
+
+// IsNumber adds an IsNumber assertion to the chain
+func (r *AssertionChain) IsNumber(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, IsNumber(expression))
+}
+
+// IsInteger adds an IsInteger assertion to the chain
+func (r *AssertionChain) IsInteger(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, IsInteger(expression))
+}
+
+// IsBool adds an IsBool assertion to the chain
+func (r *AssertionChain) IsBool(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, IsBool(expression))
+}
+
+// IsArray adds an IsArray assertion to the chain
+func (r *AssertionChain) IsArray(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, IsArray(expression))
+}
+
+// IsObject adds an IsObject assertion to the chain
+func (r *AssertionChain) IsObject(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, IsObject(expression))
+}
+
+// IsNull adds an IsNull assertion to the chain
+func (r *AssertionChain) IsNull(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, IsNull(expression))
+}
+
+// EqualIgnoring adds an EqualIgnoring assertion to the chain
+func (r *AssertionChain) EqualIgnoring(expression string, expected interface{}, ignorePaths ...string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, EqualIgnoring(expression, expected, ignorePaths...))
+}
+
+// EqualSubset adds an EqualSubset assertion to the chain
+func (r *AssertionChain) EqualSubset(expression string, expected interface{}) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, EqualSubset(expression, expected))
+}
+
+// ContainsSubset adds an ContainsSubset assertion to the chain
+func (r *AssertionChain) ContainsSubset(expression string, expected interface{}) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, ContainsSubset(expression, expected))
 }
 
 // Present adds an Present assertion to the chain
 func (r *AssertionChain) Present(expression string) *AssertionChain {
-	r.assertions = append(r.assertions, Present(r.rootExpression+expression))
-	return r
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, Present(expression))
 }
 
 // NotPresent adds an NotPresent assertion to the chain
 func (r *AssertionChain) NotPresent(expression string) *AssertionChain {
-	r.assertions = append(r.assertions, NotPresent(r.rootExpression+expression))
-	return r
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, NotPresent(expression))
+}
+
+// Exists adds an Exists assertion to the chain
+func (r *AssertionChain) Exists(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, Exists(expression))
+}
+
+// NotExists adds an NotExists assertion to the chain
+func (r *AssertionChain) NotExists(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, NotExists(expression))
+}
+
+// NotNull adds an NotNull assertion to the chain
+func (r *AssertionChain) NotNull(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, NotNull(expression))
+}
+
+// NotEmpty adds an NotEmpty assertion to the chain
+func (r *AssertionChain) NotEmpty(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, NotEmpty(expression))
+}
+
+// MatchesSchema adds an MatchesSchema assertion to the chain
+func (r *AssertionChain) MatchesSchema(expression string, schema interface{}) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, MatchesSchema(expression, schema))
 }
 
 // Matches adds an Matches assertion to the chain
 func (r *AssertionChain) Matches(expression, regexp string) *AssertionChain {
-	r.assertions = append(r.assertions, Matches(r.rootExpression+expression, regexp))
-	return r
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, Matches(expression, regexp))
+}
+
+// MatchesAll adds an MatchesAll assertion to the chain
+func (r *AssertionChain) MatchesAll(expression, regexp string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, MatchesAll(expression, regexp))
+}
+
+// MatchesAny adds an MatchesAny assertion to the chain
+func (r *AssertionChain) MatchesAny(expression, regexp string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, MatchesAny(expression, regexp))
+}
+
+// MatchesAndCapture adds an MatchesAndCapture assertion to the chain
+func (r *AssertionChain) MatchesAndCapture(expression, regexp string, captures map[string]string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, MatchesAndCapture(expression, regexp, captures))
+}
+
+// SortedBy adds an SortedBy assertion to the chain
+func (r *AssertionChain) SortedBy(expression string, keyExpression string, direction Direction) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, SortedBy(expression, keyExpression, direction))
+}
+
+// SortedByKeys adds an SortedByKeys assertion to the chain
+func (r *AssertionChain) SortedByKeys(expression string, keys ...SortKey) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, SortedByKeys(expression, keys...))
+}
+
+// Unique adds an Unique assertion to the chain
+func (r *AssertionChain) Unique(expression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, Unique(expression))
+}
+
+// UniqueBy adds an UniqueBy assertion to the chain
+func (r *AssertionChain) UniqueBy(expression string, keyExpression string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, UniqueBy(expression, keyExpression))
+}
+
+// ElementsMatch adds an ElementsMatch assertion to the chain
+func (r *AssertionChain) ElementsMatch(expression string, expected interface{}) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, ElementsMatch(expression, expected))
+}
+
+// InDelta adds an InDelta assertion to the chain
+func (r *AssertionChain) InDelta(expression string, expected interface{}, delta float64) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, InDelta(expression, expected, delta))
+}
+
+// InEpsilon adds an InEpsilon assertion to the chain
+func (r *AssertionChain) InEpsilon(expression string, expected interface{}, epsilon float64) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, InEpsilon(expression, expected, epsilon))
+}
+
+// Before adds an Before assertion to the chain
+func (r *AssertionChain) Before(expression string, t time.Time, layouts ...string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, Before(expression, t, layouts...))
+}
+
+// After adds an After assertion to the chain
+func (r *AssertionChain) After(expression string, t time.Time, layouts ...string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, After(expression, t, layouts...))
+}
+
+// WithinDuration adds an WithinDuration assertion to the chain
+func (r *AssertionChain) WithinDuration(expression string, t time.Time, delta time.Duration, layouts ...string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, WithinDuration(expression, t, delta, layouts...))
+}
+
+// BeforeNow adds an BeforeNow assertion to the chain
+func (r *AssertionChain) BeforeNow(expression string, layouts ...string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, BeforeNow(expression, layouts...))
 }
 
-// End returns an func(*http.Response, *http.Request) error which is a combination of the registered assertions
+// AfterNow adds an AfterNow assertion to the chain
+func (r *AssertionChain) AfterNow(expression string, layouts ...string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, AfterNow(expression, layouts...))
+}
+
+// WithinDurationOfNow adds an WithinDurationOfNow assertion to the chain
+func (r *AssertionChain) WithinDurationOfNow(expression string, delta time.Duration, layouts ...string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, WithinDurationOfNow(expression, delta, layouts...))
+}
+
+// BeforeNowAt adds an BeforeNowAt assertion to the chain
+func (r *AssertionChain) BeforeNowAt(expression string, clock func() time.Time, layouts ...string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, BeforeNowAt(expression, clock, layouts...))
+}
+
+// AfterNowAt adds an AfterNowAt assertion to the chain
+func (r *AssertionChain) AfterNowAt(expression string, clock func() time.Time, layouts ...string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, AfterNowAt(expression, clock, layouts...))
+}
+
+// WithinDurationOfNowAt adds an WithinDurationOfNowAt assertion to the chain
+func (r *AssertionChain) WithinDurationOfNowAt(expression string, clock func() time.Time, delta time.Duration, layouts ...string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, WithinDurationOfNowAt(expression, clock, delta, layouts...))
+}
+
+// Format adds an Format assertion to the chain
+func (r *AssertionChain) Format(expression string, format string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, Format(expression, format))
+}
+
+// HasPrefix adds an HasPrefix assertion to the chain
+func (r *AssertionChain) HasPrefix(expression string, prefix string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, HasPrefix(expression, prefix))
+}
+
+// HasSuffix adds an HasSuffix assertion to the chain
+func (r *AssertionChain) HasSuffix(expression string, suffix string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, HasSuffix(expression, suffix))
+}
+
+// ContainsString adds an ContainsString assertion to the chain
+func (r *AssertionChain) ContainsString(expression string, substring string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, ContainsString(expression, substring))
+}
+
+// EqualFold adds an EqualFold assertion to the chain
+func (r *AssertionChain) EqualFold(expression string, expected string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, EqualFold(expression, expected))
+}
+
+// EqualNormalized adds an EqualNormalized assertion to the chain
+func (r *AssertionChain) EqualNormalized(expression string, expected string) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, EqualNormalized(expression, expected))
+}
+
+// RuneLen adds an RuneLen assertion to the chain
+func (r *AssertionChain) RuneLen(expression string, expectedLength int) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, RuneLen(expression, expectedLength))
+}
+
+// MinRuneLen adds an MinRuneLen assertion to the chain
+func (r *AssertionChain) MinRuneLen(expression string, minLength int) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, MinRuneLen(expression, minLength))
+}
+
+// MaxRuneLen adds an MaxRuneLen assertion to the chain
+func (r *AssertionChain) MaxRuneLen(expression string, maxLength int) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, MaxRuneLen(expression, maxLength))
+}
+
+// All adds an All assertion to the chain
+func (r *AssertionChain) All(expression string, assertion func(*http.Response, *http.Request) error) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, All(expression, assertion))
+}
+
+// Any adds an Any assertion to the chain
+func (r *AssertionChain) Any(expression string, assertion func(*http.Response, *http.Request) error) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, Any(expression, assertion))
+}
+
+// None adds an None assertion to the chain
+func (r *AssertionChain) None(expression string, assertion func(*http.Response, *http.Request) error) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, None(expression, assertion))
+}
+
+// MatchesSnapshot adds an MatchesSnapshot assertion to the chain
+func (r *AssertionChain) MatchesSnapshot(expression string, name string, redactions ...Redaction) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, MatchesSnapshot(expression, name, redactions...))
+}
+
+// JWTHeaderEqual adds an JWTHeaderEqual assertion to the chain. The expression is evaluated against the token header,
+// so the root expression of the chain is not applied to it
+func (r *AssertionChain) JWTHeaderEqual(tokenSelector func(*http.Response) (string, error), expression string, expected interface{}) *AssertionChain {
+	return r.add(expression, JWTHeaderEqual(tokenSelector, expression, expected))
+}
+
+// JWTPayloadEqual adds an JWTPayloadEqual assertion to the chain. The expression is evaluated against the token payload,
+// so the root expression of the chain is not applied to it
+func (r *AssertionChain) JWTPayloadEqual(tokenSelector func(*http.Response) (string, error), expression string, expected interface{}) *AssertionChain {
+	return r.add(expression, JWTPayloadEqual(tokenSelector, expression, expected))
+}
+
+// Satisfies adds an Satisfies assertion to the chain
+func (r *AssertionChain) Satisfies(expression string, predicate func(value interface{}) error) *AssertionChain {
+	expression = jsonpath.JoinPath(r.rootExpression, expression)
+	return r.add(expression, Satisfies(expression, predicate))
+}
+
+// Assert adds a custom assertion to the chain, e.g. one built with Not, And or Or. Its expressions are not prefixed
+// with the root expression of the chain
+func (r *AssertionChain) Assert(assertion func(*http.Response, *http.Request) error) *AssertionChain {
+	return r.add("", assertion)
+}
+
+// End returns an func(*http.Response, *http.Request) error which is a combination of the registered assertions. In soft
+// mode the error lists every failing expression with its message
 func (r *AssertionChain) End() func(*http.Response, *http.Request) error {
-	return func(res *http.Response, req *http.Request) error {
-		for _, assertion := range r.assertions {
-			if err := assertion(httputil.CopyResponse(res), httputil.CopyRequest(req)); err != nil {
-				return err
+	if r.eachExpression != "" {
+		return forEach(r.eachExpression, r)
+	}
+	return r.run
+}
+
+func (r *AssertionChain) run(res *http.Response, req *http.Request) error {
+	failures := r.failures(res, req)
+	if len(failures) == 0 {
+		return nil
+	}
+	if !r.soft {
+		return failures[0].err
+	}
+
+	messages := make([]string, len(failures))
+	for i, failure := range failures {
+		message := strings.Replace(failure.err.Error(), "\n", "\n  ", -1)
+		if failure.expression == "" || strings.HasPrefix(message, `"`+failure.expression+`"`) {
+			messages[i] = "  " + message
+		} else {
+			messages[i] = "  " + failure.expression + ": " + message
+		}
+	}
+	return fmt.Errorf("%d of %d assertions failed:\n%s", len(failures), len(r.assertions), strings.Join(messages, "\n"))
+}
+
+// chainFailure is the error of a failed chain assertion together with the expression it was added with
+type chainFailure struct {
+	expression string
+	err        error
+}
+
+// failures runs the assertions of the chain and returns the ones that failed. Unless the chain is soft it stops at the
+// first failure
+func (r *AssertionChain) failures(res *http.Response, req *http.Request) []chainFailure {
+	var failures []chainFailure
+	for _, a := range r.assertions {
+		if err := a.assertion(httputil.CopyResponse(res), httputil.CopyRequest(req)); err != nil {
+			failures = append(failures, chainFailure{expression: a.expression, err: err})
+			if !r.soft {
+				break
 			}
 		}
-		return nil
 	}
+	return failures
 }
@@ -0,0 +1,52 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Not passes when the assertion fails. The failure message names the expression unless it is empty. A SetupError is
+// returned as is, so an invalid pattern or a body that cannot be decoded does not make Not pass
+func Not(expression string, assertion func() error) error {
+	err := assertion()
+	switch {
+	case err == nil && expression == "":
+		return errors.New("assertion passed but was expected to fail")
+	case err == nil:
+		return fmt.Errorf("assertion on \"%s\" passed but was expected to fail", expression)
+	case isSetupError(err):
+		return err
+	}
+	return nil
+}
+
+// And passes when every assertion passes. Unlike a chain it runs all of them and reports every failure
+func And(assertions []func() error) error {
+	var failures []string
+	for _, assertion := range assertions {
+		if err := assertion(); err != nil {
+			failures = append(failures, "  - "+indent(err.Error()))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("%d of %d assertions failed:\n%s", len(failures), len(assertions), strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+// Or passes when at least one assertion passes. Assertions after the first one that passes are not run
+func Or(assertions []func() error) error {
+	if len(assertions) == 0 {
+		return errors.New("no assertions given")
+	}
+	failures := make([]string, 0, len(assertions))
+	for _, assertion := range assertions {
+		err := assertion()
+		if err == nil {
+			return nil
+		}
+		failures = append(failures, "  - "+indent(err.Error()))
+	}
+	return fmt.Errorf("none of %d assertions passed:\n%s", len(assertions), strings.Join(failures, "\n"))
+}
@@ -0,0 +1,101 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// NotEqualError describes a failed equality check, rendering both values as JSON and listing the differences by path
+func NotEqualError(expression string, expected, actual interface{}) error {
+	return errors.New(fmt.Sprintf("\"%s\" not equal to expected value\n", expression) + describeDiff(expression, expected, actual))
+}
+
+func describeDiff(expression string, expected, actual interface{}) string {
+	if converted, err := toJSON(expected); err == nil {
+		expected = converted
+	}
+	if converted, err := toJSON(actual); err == nil {
+		actual = converted
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "  expected: %s\n  actual:   %s", formatValue(expected), formatValue(actual))
+	if differences := diff(expression, expected, actual); len(differences) > 0 {
+		b.WriteString("\n  diff:")
+		for _, difference := range differences {
+			b.WriteString("\n    " + difference)
+		}
+	}
+	return b.String()
+}
+
+// diff lists the paths where actual differs from expected. Values only in expected are removed, values only in
+// actual are added and values in both are changed
+func diff(path string, expected, actual interface{}) []string {
+	switch exp := expected.(type) {
+	case map[string]interface{}:
+		act, ok := actual.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(exp)+len(act))
+		for key := range exp {
+			keys = append(keys, key)
+		}
+		for key := range act {
+			if _, ok := exp[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		var differences []string
+		for _, key := range keys {
+			e, inExpected := exp[key]
+			a, inActual := act[key]
+			switch {
+			case !inActual:
+				differences = append(differences, removed(childPath(path, key), e))
+			case !inExpected:
+				differences = append(differences, added(childPath(path, key), a))
+			default:
+				differences = append(differences, diff(childPath(path, key), e, a)...)
+			}
+		}
+		return differences
+	case []interface{}:
+		act, ok := actual.([]interface{})
+		if !ok {
+			break
+		}
+
+		var differences []string
+		for i := 0; i < len(exp) || i < len(act); i++ {
+			switch {
+			case i >= len(act):
+				differences = append(differences, removed(indexPath(path, i), exp[i]))
+			case i >= len(exp):
+				differences = append(differences, added(indexPath(path, i), act[i]))
+			default:
+				differences = append(differences, diff(indexPath(path, i), exp[i], act[i])...)
+			}
+		}
+		return differences
+	}
+
+	if ObjectsAreEqual(expected, actual) {
+		return nil
+	}
+	return []string{fmt.Sprintf("changed %s: %s -> %s", path, formatValue(expected), formatValue(actual))}
+}
+
+func removed(path string, value interface{}) string {
+	return fmt.Sprintf("removed %s: %s", path, formatValue(value))
+}
+
+func added(path string, value interface{}) string {
+	return fmt.Sprintf("added   %s: %s", path, formatValue(value))
+}
@@ -0,0 +1,212 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Element is a value selected from a JSON document together with its path
+type Element struct {
+	Path  string
+	Value interface{}
+}
+
+// Elements returns the values selected by a wildcard expression such as `$.items[*]`, or the elements of the array
+// selected by expression
+func Elements(expression string, data io.Reader) ([]Element, error) {
+	doc, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if steps, err := parseSteps(expression); err == nil && !definite(steps) {
+		return selectElements(doc, expression)
+	}
+
+	value, err := get(doc, expression)
+	if err != nil {
+		return nil, err
+	}
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"%s\" has type %s, expected %s", expression, jsonType(value), typeArray)
+	}
+	elements := make([]Element, len(values))
+	for i, v := range values {
+		elements[i] = Element{Path: indexPath(expression, i), Value: v}
+	}
+	return elements, nil
+}
+
+// selectValues returns the value selected by a definite expression such as `$.id`, or every value selected by a
+// wildcard expression such as `$.items[*].id`
+func selectValues(expression string, data io.Reader) ([]Element, error) {
+	doc, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if steps, err := parseSteps(expression); err == nil && !definite(steps) {
+		return selectElements(doc, expression)
+	}
+
+	value, err := get(doc, expression)
+	if err != nil {
+		return nil, err
+	}
+	return []Element{{Path: expression, Value: value}}, nil
+}
+
+func selectElements(doc interface{}, expression string) ([]Element, error) {
+	matches, err := selectPaths(doc, expression)
+	if err != nil {
+		return nil, err
+	}
+	elements := make([]Element, len(matches))
+	for i, m := range matches {
+		elements[i] = Element{Path: formatPath("$", m.location), Value: m.value}
+	}
+	return elements, nil
+}
+
+// definite reports whether the steps select at most one value
+func definite(steps []step) bool {
+	for _, s := range steps {
+		if s.wildcard || s.descent {
+			return false
+		}
+	}
+	return true
+}
+
+// All passes when the assertion passes for every element. The assertion receives each element encoded as JSON
+func All(expression string, assertion func(element []byte) error, data io.Reader) error {
+	elements, errs, err := runElements(expression, assertion, data)
+	if err != nil {
+		return err
+	}
+	if failures := describeFailures(elements, errs); len(failures) > 0 {
+		return fmt.Errorf("%d of %d elements of \"%s\" failed:\n%s", len(failures), len(elements), expression, strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+// Any passes when the assertion passes for at least one element
+func Any(expression string, assertion func(element []byte) error, data io.Reader) error {
+	elements, errs, err := runElements(expression, assertion, data)
+	if err != nil {
+		return err
+	}
+	if len(elements) == 0 {
+		return fmt.Errorf("no elements of \"%s\" passed: \"%s\" is empty", expression, expression)
+	}
+	if failures := describeFailures(elements, errs); len(failures) == len(elements) {
+		return fmt.Errorf("no elements of \"%s\" passed:\n%s", expression, strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+// None passes when the assertion fails for every element
+func None(expression string, assertion func(element []byte) error, data io.Reader) error {
+	elements, errs, err := runElements(expression, assertion, data)
+	if err != nil {
+		return err
+	}
+	var passed []string
+	for i, element := range elements {
+		if errs[i] == nil {
+			passed = append(passed, element.Path)
+		}
+	}
+	if len(passed) > 0 {
+		return fmt.Errorf("%d of %d elements of \"%s\" passed, expected none: %s", len(passed), len(elements), expression, strings.Join(passed, ", "))
+	}
+	return nil
+}
+
+// ElementsMatch passes when the selected elements and expected contain the same elements with the same multiplicity,
+// in any order
+func ElementsMatch(expression string, expected interface{}, data io.Reader) error {
+	elements, err := Elements(expression, data)
+	if err != nil {
+		return err
+	}
+
+	exp, err := toJSON(expected)
+	if err != nil {
+		return err
+	}
+	expectedElements, ok := exp.([]interface{})
+	if !ok {
+		return fmt.Errorf("expected value has type %s, expected %s", jsonType(exp), typeArray)
+	}
+
+	matched := make([]bool, len(elements))
+	var missing []interface{}
+	for _, e := range expectedElements {
+		found := false
+		for i, element := range elements {
+			if !matched[i] && ObjectsAreEqual(element.Value, e) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, e)
+		}
+	}
+	var extra []interface{}
+	for i, element := range elements {
+		if !matched[i] {
+			extra = append(extra, element.Value)
+		}
+	}
+	if len(missing) == 0 && len(extra) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("\"%s\" elements do not match expected elements", expression)
+	if len(missing) > 0 {
+		message += "\n  missing: " + formatValue(missing)
+	}
+	if len(extra) > 0 {
+		message += "\n  extra:   " + formatValue(extra)
+	}
+	return errors.New(message)
+}
+
+// runElements runs the assertion against every element and returns the result for each one
+func runElements(expression string, assertion func(element []byte) error, data io.Reader) ([]Element, []error, error) {
+	elements, err := Elements(expression, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errs := make([]error, len(elements))
+	for i, element := range elements {
+		b, err := json.Marshal(element.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+		errs[i] = assertion(b)
+	}
+	return elements, errs, nil
+}
+
+func describeFailures(elements []Element, errs []error) []string {
+	var failures []string
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, "  "+elements[i].Path+": "+indent(err.Error()))
+		}
+	}
+	return failures
+}
+
+// indent indents every line after the first so that multi-line errors stay readable when nested
+func indent(message string) string {
+	return strings.Replace(message, "\n", "\n  ", -1)
+}
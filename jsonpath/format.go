@@ -0,0 +1,87 @@
+package jsonpath
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+var (
+	formatsMu sync.RWMutex
+	formats   = defaultFormats()
+)
+
+// defaultFormats returns the validators of the JSON Schema format vocabulary together with base64
+func defaultFormats() map[string]func(string) bool {
+	validators := make(map[string]func(string) bool, len(jsonschema.Formats)+1)
+	for name, validate := range jsonschema.Formats {
+		validate := validate
+		validators[name] = func(value string) bool {
+			return validate(value)
+		}
+	}
+	validators["base64"] = func(value string) bool {
+		_, err := base64.StdEncoding.DecodeString(value)
+		return err == nil
+	}
+	return validators
+}
+
+// RegisterFormat registers a validator for a format, replacing any validator already registered with the name. It is
+// safe to call concurrently with Format
+func RegisterFormat(name string, validate func(value string) bool) {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+	formats[name] = validate
+}
+
+func lookupFormat(name string) (func(string) bool, bool) {
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+	validate, ok := formats[name]
+	return validate, ok
+}
+
+func Format(expression string, format string, data io.Reader) error {
+	validate, ok := lookupFormat(format)
+	if !ok {
+		return fmt.Errorf("unknown format '%s', expected one of %s", format, strings.Join(formatNames(), ", "))
+	}
+
+	values, err := selectValues(expression, data)
+	if err != nil {
+		return err
+	}
+
+	var invalid []string
+	for _, value := range values {
+		s, ok := value.Value.(string)
+		if !ok {
+			invalid = append(invalid, fmt.Sprintf("%s: has type %s, expected %s", value.Path, jsonType(value.Value), typeString))
+			continue
+		}
+		if !validate(s) {
+			invalid = append(invalid, fmt.Sprintf("%s: %s", value.Path, formatValue(s)))
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("\"%s\" is not a valid %s:\n  %s", expression, format, strings.Join(invalid, "\n  "))
+	}
+	return nil
+}
+
+func formatNames() []string {
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
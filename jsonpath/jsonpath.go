@@ -2,14 +2,19 @@ package jsonpath
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
+	"math/big"
 	"reflect"
+	"strconv"
 	"strings"
 
+	"github.com/PaesslerAG/gval"
 	"github.com/PaesslerAG/jsonpath"
 )
 
@@ -20,10 +25,10 @@ func Contains(expression string, expected interface{}, data io.Reader) error {
 	}
 	ok, found := IncludesElement(value, expected)
 	if !ok {
-		return fmt.Errorf("\"%s\" could not be applied builtin len()", expected)
+		return fmt.Errorf("%s could not be applied builtin len()", formatValue(value))
 	}
 	if !found {
-		return fmt.Errorf("\"%s\" does not contain \"%s\"", value, expected)
+		return fmt.Errorf("\"%s\" does not contain expected value\n  expected: %s\n  actual:   %s", expression, formatValue(expected), formatValue(value))
 	}
 	return nil
 }
@@ -34,7 +39,7 @@ func Equal(expression string, expected interface{}, data io.Reader) error {
 		return err
 	}
 	if !ObjectsAreEqual(value, expected) {
-		return fmt.Errorf("\"%s\" not equal to \"%s\"", value, expected)
+		return NotEqualError(expression, expected, value)
 	}
 	return nil
 }
@@ -46,7 +51,33 @@ func NotEqual(expression string, expected interface{}, data io.Reader) error {
 	}
 
 	if ObjectsAreEqual(value, expected) {
-		return fmt.Errorf("\"%s\" value is equal to \"%s\"", expression, expected)
+		return fmt.Errorf("\"%s\" value is equal to %s", expression, formatValue(expected))
+	}
+	return nil
+}
+
+func EqualIgnoring(expression string, expected interface{}, ignorePaths []string, data io.Reader) error {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return err
+	}
+
+	exp, err := toJSON(expected)
+	if err != nil {
+		return err
+	}
+
+	value, err = removePaths(value, ignorePaths)
+	if err != nil {
+		return err
+	}
+	exp, err = removePaths(exp, ignorePaths)
+	if err != nil {
+		return err
+	}
+
+	if !ObjectsAreEqual(value, exp) {
+		return NotEqualError(expression, exp, value)
 	}
 	return nil
 }
@@ -102,6 +133,195 @@ func LessThan(expression string, maximumLength int, data io.Reader) error {
 	return nil
 }
 
+func NumberGreaterThan(expression string, bound float64, data io.Reader) error {
+	return compareNumber(expression, data, fmt.Sprintf("greater than \"%s\"", formatNumber(bound)), func(n float64) bool {
+		return n > bound
+	})
+}
+
+func NumberLessThan(expression string, bound float64, data io.Reader) error {
+	return compareNumber(expression, data, fmt.Sprintf("less than \"%s\"", formatNumber(bound)), func(n float64) bool {
+		return n < bound
+	})
+}
+
+func NumberAtLeast(expression string, bound float64, data io.Reader) error {
+	return compareNumber(expression, data, fmt.Sprintf("at least \"%s\"", formatNumber(bound)), func(n float64) bool {
+		return n >= bound
+	})
+}
+
+func NumberAtMost(expression string, bound float64, data io.Reader) error {
+	return compareNumber(expression, data, fmt.Sprintf("at most \"%s\"", formatNumber(bound)), func(n float64) bool {
+		return n <= bound
+	})
+}
+
+func NumberBetween(expression string, min, max float64, data io.Reader) error {
+	description := fmt.Sprintf("between \"%s\" and \"%s\"", formatNumber(min), formatNumber(max))
+	return compareNumber(expression, data, description, func(n float64) bool {
+		return n >= min && n <= max
+	})
+}
+
+func compareNumber(expression string, data io.Reader, description string, compare func(float64) bool) error {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return err
+	}
+
+	if value == nil {
+		return errors.New("value is null")
+	}
+
+	n, ok := toNumber(value)
+	if !ok {
+		return fmt.Errorf("%s is not a number", formatValue(value))
+	}
+	if !compare(n) {
+		return fmt.Errorf("\"%s\" is not %s", formatNumber(n), description)
+	}
+	return nil
+}
+
+func toNumber(value interface{}) (float64, bool) {
+	var n float64
+	switch v := value.(type) {
+	case float64:
+		n = v
+	case float32:
+		n = float64(v)
+	case int:
+		n = float64(v)
+	case int8:
+		n = float64(v)
+	case int16:
+		n = float64(v)
+	case int32:
+		n = float64(v)
+	case int64:
+		n = float64(v)
+	case uint:
+		n = float64(v)
+	case uint8:
+		n = float64(v)
+	case uint16:
+		n = float64(v)
+	case uint32:
+		n = float64(v)
+	case uint64:
+		n = float64(v)
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return 0, false
+		}
+		n = f
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, false
+		}
+		n = f
+	default:
+		return 0, false
+	}
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, false
+	}
+	return n, true
+}
+
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+const (
+	typeString  = "string"
+	typeNumber  = "number"
+	typeInteger = "integer"
+	typeBoolean = "boolean"
+	typeArray   = "array"
+	typeObject  = "object"
+	typeNull    = "null"
+)
+
+func IsString(expression string, data io.Reader) error {
+	return hasType(expression, typeString, data)
+}
+
+func IsNumber(expression string, data io.Reader) error {
+	return hasType(expression, typeNumber, data)
+}
+
+func IsInteger(expression string, data io.Reader) error {
+	return hasType(expression, typeInteger, data)
+}
+
+func IsBool(expression string, data io.Reader) error {
+	return hasType(expression, typeBoolean, data)
+}
+
+func IsArray(expression string, data io.Reader) error {
+	return hasType(expression, typeArray, data)
+}
+
+func IsObject(expression string, data io.Reader) error {
+	return hasType(expression, typeObject, data)
+}
+
+func IsNull(expression string, data io.Reader) error {
+	return hasType(expression, typeNull, data)
+}
+
+func hasType(expression string, expectedType string, data io.Reader) error {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return err
+	}
+
+	actualType := jsonType(value)
+	if actualType == expectedType {
+		return nil
+	}
+	if expectedType == typeInteger && actualType == typeNumber {
+		if n, _ := toNumber(value); n == math.Trunc(n) {
+			return nil
+		}
+	}
+	return fmt.Errorf("\"%s\" has type %s, expected %s", expression, actualType, expectedType)
+}
+
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return typeNull
+	case string:
+		return typeString
+	case bool:
+		return typeBoolean
+	case []interface{}:
+		return typeArray
+	case map[string]interface{}:
+		return typeObject
+	}
+	if _, ok := toNumber(value); ok {
+		return typeNumber
+	}
+	return reflect.TypeOf(value).String()
+}
+
+func Satisfies(expression string, predicate func(value interface{}) error, data io.Reader) error {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return err
+	}
+	if err := predicate(value); err != nil {
+		return fmt.Errorf("\"%s\" does not satisfy predicate: %s", expression, err)
+	}
+	return nil
+}
+
 func Present(expression string, data io.Reader) error {
 	value, _ := JsonPath(data, expression)
 	if isEmpty(value) {
@@ -118,7 +338,92 @@ func NotPresent(expression string, data io.Reader) error {
 	return nil
 }
 
+func Exists(expression string, data io.Reader) error {
+	exists, err := exists(expression, data)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("value does not exist for expression: '%s'", expression)
+	}
+	return nil
+}
+
+func NotExists(expression string, data io.Reader) error {
+	exists, err := exists(expression, data)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("value exists for expression: '%s'", expression)
+	}
+	return nil
+}
+
+func NotNull(expression string, data io.Reader) error {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		return fmt.Errorf("value is null for expression: '%s'", expression)
+	}
+	return nil
+}
+
+func NotEmpty(expression string, data io.Reader) error {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case nil:
+		return fmt.Errorf("value is null for expression: '%s'", expression)
+	case string:
+		if v == "" {
+			return fmt.Errorf("value is empty for expression: '%s'", expression)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return fmt.Errorf("value is empty for expression: '%s'", expression)
+		}
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return fmt.Errorf("value is empty for expression: '%s'", expression)
+		}
+	}
+	return nil
+}
+
+func exists(expression string, data io.Reader) (bool, error) {
+	v, err := decode(data)
+	if err != nil {
+		return false, err
+	}
+	eval, err := jsonpath.New(expression)
+	if err != nil {
+		return false, fmt.Errorf("invalid expression '%s': '%s'", expression, err)
+	}
+	if !strings.HasPrefix(expression, "$") {
+		_, err = eval(context.Background(), v)
+		return err == nil, nil
+	}
+	matches, err := locate(v, expression)
+	if err != nil {
+		return false, err
+	}
+	return len(matches) > 0, nil
+}
+
 func JsonPath(reader io.Reader, expression string) (interface{}, error) {
+	v, err := decode(reader)
+	if err != nil {
+		return nil, err
+	}
+	return get(v, expression)
+}
+
+func decode(reader io.Reader) (interface{}, error) {
 	v := interface{}(nil)
 	b, err := ioutil.ReadAll(reader)
 	if err != nil {
@@ -127,16 +432,62 @@ func JsonPath(reader io.Reader, expression string) (interface{}, error) {
 
 	err = json.Unmarshal(b, &v)
 	if err != nil {
-		return nil, err
+		return nil, &SetupError{Err: err}
 	}
+	return v, nil
+}
 
-	value, err := jsonpath.Get(expression, v)
+func get(v interface{}, expression string) (interface{}, error) {
+	eval, err := jsonpath.New(expression)
+	if err != nil {
+		return nil, &SetupError{Err: fmt.Errorf("evaluating '%s' resulted in error: '%s'", expression, err)}
+	}
+	value, err := eval(context.Background(), v)
 	if err != nil {
 		return nil, fmt.Errorf("evaluating '%s' resulted in error: '%s'", expression, err)
 	}
 	return value, nil
 }
 
+// locate evaluates the expression like get but returns each matched value keyed by the values of the wildcards which
+// selected it, e.g. $["1"] for the second element of $.items[*]. A definite expression is keyed by $ and an expression
+// which matches nothing returns an empty map, so a wildcard without matches is distinguished from an empty array
+func locate(v interface{}, expression string) (map[string]interface{}, error) {
+	if _, err := gval.Full(jsonpath.Language()).NewEvaluable(expression); err != nil {
+		return nil, &SetupError{Err: fmt.Errorf("invalid expression '%s': '%s'", expression, err)}
+	}
+	eval, err := gval.Full(jsonpath.PlaceholderExtension()).NewEvaluable("{#: " + expression + "}")
+	if err != nil {
+		return nil, &SetupError{Err: fmt.Errorf("invalid expression '%s': '%s'", expression, err)}
+	}
+	value, err := eval(context.Background(), v)
+	if err != nil {
+		return nil, &SetupError{Err: fmt.Errorf("evaluating '%s' resulted in error: '%s'", expression, err)}
+	}
+	matches, _ := value.(map[string]interface{})
+	return matches, nil
+}
+
+// SetupError reports that an assertion could not be evaluated because the body is not valid JSON, the expression does
+// not parse or the pattern does not compile. Not returns it instead of treating it as a failed assertion. A key that
+// is missing from the body is not a SetupError
+type SetupError struct {
+	Err error
+}
+
+func (e *SetupError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SetupError) Unwrap() error {
+	return e.Err
+}
+
+func isSetupError(err error) bool {
+	var setupErr *SetupError
+	return errors.As(err, &setupErr)
+}
+
 // courtesy of github.com/stretchr/testify
 func IncludesElement(list interface{}, element interface{}) (ok, found bool) {
 	listValue := reflect.ValueOf(list)
@@ -177,7 +528,7 @@ func ObjectsAreEqual(expected, actual interface{}) bool {
 
 	exp, ok := expected.([]byte)
 	if !ok {
-		return reflect.DeepEqual(expected, actual)
+		return valuesAreEqual(reflect.ValueOf(expected), reflect.ValueOf(actual))
 	}
 
 	act, ok := actual.([]byte)
@@ -190,6 +541,198 @@ func ObjectsAreEqual(expected, actual interface{}) bool {
 	return bytes.Equal(exp, act)
 }
 
+// valuesAreEqual compares numbers by value regardless of their Go type and recurses into maps and slices so that
+// expected values can be written with any numeric type. Structs are compared through their JSON encoding
+func valuesAreEqual(expected, actual reflect.Value) bool {
+	expected, actual = indirect(toJSONValue(expected)), indirect(toJSONValue(actual))
+	if !expected.IsValid() || !actual.IsValid() {
+		return expected.IsValid() == actual.IsValid()
+	}
+
+	if isNumber(expected) || isNumber(actual) {
+		return numbersAreEqual(expected, actual)
+	}
+
+	switch expected.Kind() {
+	case reflect.Map:
+		if actual.Kind() != reflect.Map || expected.Len() != actual.Len() {
+			return false
+		}
+		for _, key := range expected.MapKeys() {
+			value, ok := mapIndex(actual, key)
+			if !ok || !valuesAreEqual(expected.MapIndex(key), value) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice, reflect.Array:
+		if actual.Kind() != reflect.Slice && actual.Kind() != reflect.Array || expected.Len() != actual.Len() {
+			return false
+		}
+		for i := 0; i < expected.Len(); i++ {
+			if !valuesAreEqual(expected.Index(i), actual.Index(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(expected.Interface(), actual.Interface())
+}
+
+var marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// toJSONValue decodes the JSON encoding of structs and types implementing json.Marshaler, so that json tags,
+// omitempty and custom MarshalJSON methods are honoured when comparing them with decoded JSON
+func toJSONValue(v reflect.Value) reflect.Value {
+	if v.IsValid() && v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || !v.CanInterface() {
+		return v
+	}
+
+	t := v.Type()
+	for t.Kind() == reflect.Ptr && !t.Implements(marshalerType) {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct && !t.Implements(marshalerType) {
+		return v
+	}
+
+	decoded, err := toJSON(v.Interface())
+	if err != nil {
+		return v
+	}
+	return reflect.ValueOf(&decoded).Elem()
+}
+
+// toJSON converts a Go value to the generic representation of its JSON encoding, keeping numbers as json.Number
+func toJSON(value interface{}) (interface{}, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func formatValue(value interface{}) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func mapIndex(m reflect.Value, key reflect.Value) (reflect.Value, bool) {
+	if key.Type().AssignableTo(m.Type().Key()) {
+		value := m.MapIndex(key)
+		return value, value.IsValid()
+	}
+	for _, k := range m.MapKeys() {
+		if valuesAreEqual(key, k) {
+			return m.MapIndex(k), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+var numberType = reflect.TypeOf(json.Number(""))
+
+func isNumber(v reflect.Value) bool {
+	if v.Type() == numberType {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// numbersAreEqual compares two integers, e.g. Go ints or integer json.Number values, exactly and any other numbers as
+// float64 values. Numbers decoded from a response are always float64, so integers in the response beyond 2^53 are
+// already rounded and compare equal to their float64 neighbours
+func numbersAreEqual(expected, actual reflect.Value) bool {
+	if exp, ok := toInt(expected); ok {
+		if act, ok := toInt(actual); ok {
+			return exp.Cmp(act) == 0
+		}
+	}
+
+	exp, ok := toFloat(expected)
+	if !ok {
+		return false
+	}
+	act, ok := toFloat(actual)
+	if !ok {
+		return false
+	}
+	return exp == act
+}
+
+func toInt(v reflect.Value) (*big.Int, bool) {
+	if v.Type() == numberType {
+		if strings.ContainsAny(v.String(), ".eE") {
+			return nil, false
+		}
+		return new(big.Int).SetString(v.String(), 10)
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(v.Uint()), true
+	}
+	return nil, false
+}
+
+func toFloat(v reflect.Value) (float64, bool) {
+	var f float64
+	if v.Type() == numberType {
+		parsed, err := strconv.ParseFloat(v.String(), 64)
+		if err != nil {
+			return 0, false
+		}
+		f = parsed
+	} else {
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			f = float64(v.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			f = float64(v.Uint())
+		case reflect.Float32:
+			// widen through the shortest decimal representation, so that float32(0.1) equals 0.1
+			f, _ = strconv.ParseFloat(strconv.FormatFloat(v.Float(), 'g', -1, 32), 64)
+		case reflect.Float64:
+			f = v.Float()
+		default:
+			return 0, false
+		}
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f, true
+}
+
 func isEmpty(object interface{}) bool {
 	if object == nil {
 		return true
@@ -0,0 +1,531 @@
+package jsonpath
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func childPath(parent string, key string) string {
+	if isIdentifier(key) {
+		return parent + "." + key
+	}
+	return parent + "[" + strconv.Quote(key) + "]"
+}
+
+func indexPath(parent string, index int) string {
+	return parent + "[" + strconv.Itoa(index) + "]"
+}
+
+func isIdentifier(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i, r := range key {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && r >= '0' && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// JoinPath joins a child expression to a root expression. The child may be a name such as `name` or `a.b`, bracket
+// notation such as `[0]` or `['x-id']`, a recursive descent such as `..id` or an expression relative to the root such
+// as `$.name`, where `$` refers to the root. Single quoted keys are rewritten with double quotes, which the jsonpath
+// library requires. An empty root leaves the child unchanged
+func JoinPath(root string, child string) string {
+	child = strings.TrimSpace(child)
+	if root == "" {
+		return normalizeQuotes(child)
+	}
+	root = strings.TrimSuffix(root, ".")
+
+	if strings.HasPrefix(child, "$") {
+		child = child[1:]
+	}
+	switch {
+	case child == "":
+		return normalizeQuotes(root)
+	case strings.HasPrefix(child, "["), strings.HasPrefix(child, "."):
+		return normalizeQuotes(root + child)
+	}
+	return normalizeQuotes(root + "." + child)
+}
+
+// normalizeQuotes rewrites bracket notation with single quoted keys, e.g. `['x-id']`, to use double quotes
+func normalizeQuotes(expression string) string {
+	var b strings.Builder
+	for i := 0; i < len(expression); i++ {
+		switch expression[i] {
+		case '\'', '"':
+			end := closingQuote(expression, i)
+			if end < 0 {
+				return expression
+			}
+			b.WriteString(expression[i : end+1])
+			i = end
+		case '[':
+			end, err := closingBracket(expression, i)
+			if err != nil {
+				return expression
+			}
+			content := expression[i+1 : end]
+			if strings.HasPrefix(strings.TrimSpace(content), "?") {
+				b.WriteString(expression[i : end+1])
+			} else {
+				b.WriteString("[" + normalizeKeys(content) + "]")
+			}
+			i = end
+		default:
+			b.WriteByte(expression[i])
+		}
+	}
+	return b.String()
+}
+
+func normalizeKeys(content string) string {
+	keys := splitTopLevel(content, ',')
+	for i, key := range keys {
+		trimmed := strings.TrimSpace(key)
+		if len(trimmed) >= 2 && trimmed[0] == '\'' && closingQuote(trimmed, 0) == len(trimmed)-1 {
+			keys[i] = strconv.Quote(unquote(trimmed))
+		}
+	}
+	return strings.Join(keys, ",")
+}
+
+// pointerToPath converts a JSON pointer within value to a jsonpath expression relative to base
+func pointerToPath(base string, value interface{}, pointer string) string {
+	path := base
+	if pointer == "" || pointer == "/" {
+		return path
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch v := value.(type) {
+		case []interface{}:
+			if i, err := strconv.Atoi(token); err == nil && i >= 0 && i < len(v) {
+				path = indexPath(path, i)
+				value = v[i]
+				continue
+			}
+		case map[string]interface{}:
+			value = v[token]
+		}
+		path = childPath(path, token)
+	}
+	return path
+}
+
+// step is a single step of a jsonpath expression. A step selecting a single child, e.g. `.name`, `["x-id"]` or `[0]`,
+// has a key. Every other step, e.g. `[*]`, `[?(@.id)]`, `[1:3]` or `[0, 1]`, is a wildcard, and `..` is a descent. A
+// bracket directly following a descent is a wildcard too. The jsonpath library reports the keys matched by wildcards
+// and descents, but not the keys of the other steps
+type step struct {
+	key      interface{}
+	wildcard bool
+	descent  bool
+}
+
+// match is a value selected by an expression together with its location as object keys and array indices
+type match struct {
+	location []interface{}
+	value    interface{}
+}
+
+// parseSteps splits an expression into its steps. It only finds out where each step selects from, the expression is
+// evaluated by the jsonpath library
+func parseSteps(expression string) ([]step, error) {
+	if !strings.HasPrefix(expression, "$") {
+		return nil, fmt.Errorf("must start with '$'")
+	}
+
+	var steps []step
+	for i := 1; i < len(expression); {
+		switch {
+		case strings.HasPrefix(expression[i:], ".."):
+			steps = append(steps, step{descent: true})
+			i += 2
+			if i < len(expression) && expression[i] == '[' {
+				continue
+			}
+		case expression[i] == '.':
+			i++
+		case expression[i] == '[':
+			end, err := closingBracket(expression, i)
+			if err != nil {
+				return nil, err
+			}
+			s, err := bracketStep(expression[i+1 : end])
+			if err != nil {
+				return nil, err
+			}
+			if len(steps) > 0 && steps[len(steps)-1].descent {
+				// the library reports the key selected by a bracket following `..`, e.g. `$..[0]` or `$..["id"]`
+				s = step{wildcard: true}
+			}
+			steps = append(steps, s)
+			i = end + 1
+			continue
+		default:
+			return nil, fmt.Errorf("unexpected '%c' at position %d", expression[i], i)
+		}
+
+		j := i
+		for j < len(expression) && expression[j] != '.' && expression[j] != '[' {
+			j++
+		}
+		switch name := expression[i:j]; name {
+		case "":
+			return nil, fmt.Errorf("expected a key at position %d", i)
+		case "*":
+			steps = append(steps, step{wildcard: true})
+		default:
+			steps = append(steps, step{key: name})
+		}
+		i = j
+	}
+	return steps, nil
+}
+
+func bracketStep(content string) (step, error) {
+	content = strings.TrimSpace(content)
+	switch {
+	case content == "*", strings.HasPrefix(content, "?"):
+		return step{wildcard: true}, nil
+	case len(splitTopLevel(content, ',')) > 1, len(splitTopLevel(content, ':')) > 1:
+		return step{wildcard: true}, nil
+	case strings.HasPrefix(content, `"`):
+		key, err := strconv.Unquote(content)
+		if err != nil {
+			return step{}, fmt.Errorf("invalid key [%s]", content)
+		}
+		return step{key: key}, nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return step{}, fmt.Errorf("unsupported selector [%s]", content)
+	}
+	return step{key: index}, nil
+}
+
+func unquote(quoted string) string {
+	if quoted[0] == '"' {
+		if s, err := strconv.Unquote(quoted); err == nil {
+			return s
+		}
+	}
+	return strings.NewReplacer(`\\`, `\`, `\'`, `'`, `\"`, `"`).Replace(quoted[1 : len(quoted)-1])
+}
+
+// closingBracket returns the index of the bracket closing the one at start, skipping quoted strings and nested brackets
+func closingBracket(s string, start int) (int, error) {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '\'', '"':
+			end := closingQuote(s, i)
+			if end < 0 {
+				return 0, fmt.Errorf("unterminated string at position %d", i)
+			}
+			i = end
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+			if depth == 0 {
+				if s[i] != ']' {
+					return 0, fmt.Errorf("unbalanced brackets at position %d", i)
+				}
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unterminated bracket at position %d", start)
+}
+
+func closingQuote(s string, start int) int {
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case s[start]:
+			return i
+		}
+	}
+	return -1
+}
+
+func splitTopLevel(s string, separator byte) []string {
+	var parts []string
+	depth, last := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\'', '"':
+			if end := closingQuote(s, i); end > 0 {
+				i = end
+			}
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+		case separator:
+			if depth == 0 {
+				parts = append(parts, s[last:i])
+				last = i + 1
+			}
+		}
+	}
+	return append(parts, s[last:])
+}
+
+// selectPaths returns every value selected by expression together with its location, ordered by location. The values
+// are selected by the jsonpath library, whose placeholders report the keys matched by the wildcards of the expression.
+// The location is found by walking value along the steps of the expression with those keys
+func selectPaths(value interface{}, expression string) ([]match, error) {
+	if !strings.HasPrefix(expression, "$") {
+		return nil, &SetupError{Err: fmt.Errorf("invalid expression '%s': must start with '$'", expression)}
+	}
+	located, err := locate(value, expression)
+	if err != nil {
+		return nil, err
+	}
+	steps, err := parseSteps(expression)
+	if err != nil {
+		return nil, &SetupError{Err: fmt.Errorf("invalid expression '%s': %s", expression, err)}
+	}
+
+	matches := make([]match, 0, len(located))
+	for placeholder, selected := range located {
+		keys, err := placeholderKeys(placeholder)
+		if err != nil {
+			return nil, err
+		}
+		location, ok := resolve(value, steps, keys)
+		if !ok {
+			return nil, fmt.Errorf("could not find the location of %s selected by '%s'", placeholder, expression)
+		}
+		matches = append(matches, match{location: location, value: selected})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return locationLess(matches[i].location, matches[j].location)
+	})
+	return matches, nil
+}
+
+// placeholderKeys parses the keys of a match as reported by the jsonpath placeholder #, e.g. $["items"]["0"]
+func placeholderKeys(placeholder string) ([]string, error) {
+	var keys []string
+	for i := 1; i < len(placeholder); {
+		end := -1
+		if strings.HasPrefix(placeholder[i:], `["`) {
+			end = closingQuote(placeholder, i+1)
+		}
+		if end < 0 || end+1 >= len(placeholder) || placeholder[end+1] != ']' {
+			return nil, fmt.Errorf("invalid placeholder %s", placeholder)
+		}
+		key, err := strconv.Unquote(placeholder[i+1 : end+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid placeholder %s", placeholder)
+		}
+		keys = append(keys, key)
+		i = end + 2
+	}
+	return keys, nil
+}
+
+// resolve returns the location reached by walking value along the steps, where every wildcard consumes one of keys and
+// a descent any number of them. It reports false when the steps and keys do not describe a location within value
+func resolve(value interface{}, steps []step, keys []string) ([]interface{}, bool) {
+	if len(steps) == 0 {
+		return []interface{}{}, len(keys) == 0
+	}
+
+	s := steps[0]
+	if s.descent {
+		for n := 0; n <= len(keys); n++ {
+			descendant, location, ok := walk(value, keys[:n])
+			if !ok {
+				return nil, false
+			}
+			if rest, ok := resolve(descendant, steps[1:], keys[n:]); ok {
+				return append(location, rest...), true
+			}
+		}
+		return nil, false
+	}
+
+	key := s.key
+	if s.wildcard {
+		if len(keys) == 0 {
+			return nil, false
+		}
+		key, keys = keys[0], keys[1:]
+	}
+	child, location, ok := walkKey(value, key)
+	if !ok {
+		return nil, false
+	}
+	rest, ok := resolve(child, steps[1:], keys)
+	if !ok {
+		return nil, false
+	}
+	return append([]interface{}{location}, rest...), true
+}
+
+func walk(value interface{}, keys []string) (interface{}, []interface{}, bool) {
+	location := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		child, k, ok := walkKey(value, key)
+		if !ok {
+			return nil, nil, false
+		}
+		value = child
+		location = append(location, k)
+	}
+	return value, location, true
+}
+
+// walkKey returns the child of value with the key, which is a string or an array index, and the key of the child as
+// it appears in a location
+func walkKey(value interface{}, key interface{}) (interface{}, interface{}, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		k, ok := key.(string)
+		if !ok {
+			k = strconv.Itoa(key.(int))
+		}
+		child, ok := v[k]
+		return child, k, ok
+	case []interface{}:
+		i, ok := key.(int)
+		if !ok {
+			n, err := strconv.Atoi(key.(string))
+			if err != nil {
+				return nil, nil, false
+			}
+			i = n
+		}
+		if i < 0 || i >= len(v) {
+			return nil, nil, false
+		}
+		return v[i], i, true
+	}
+	return nil, nil, false
+}
+
+// locationLess orders locations as they appear in the document, with object keys in lexical order
+func locationLess(a []interface{}, b []interface{}) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		ai, aIsIndex := a[i].(int)
+		bi, bIsIndex := b[i].(int)
+		switch {
+		case aIsIndex && bIsIndex:
+			if ai != bi {
+				return ai < bi
+			}
+		case aIsIndex != bIsIndex:
+			return aIsIndex
+		default:
+			if as, bs := a[i].(string), b[i].(string); as != bs {
+				return as < bs
+			}
+		}
+	}
+	return len(a) < len(b)
+}
+
+func formatPath(base string, location []interface{}) string {
+	path := base
+	for _, key := range location {
+		switch k := key.(type) {
+		case int:
+			path = indexPath(path, k)
+		case string:
+			path = childPath(path, k)
+		}
+	}
+	return path
+}
+
+// removePaths returns a copy of value without the values selected by any of the expressions
+func removePaths(value interface{}, expressions []string) (interface{}, error) {
+	removed := map[string]bool{}
+	for _, expression := range expressions {
+		matches, err := selectPaths(value, expression)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range matches {
+			removed[formatPath("$", m.location)] = true
+		}
+	}
+	if removed["$"] {
+		return nil, nil
+	}
+	return prune("$", value, removed), nil
+}
+
+func prune(path string, value interface{}, removed map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		pruned := make(map[string]interface{}, len(v))
+		for key, element := range v {
+			if p := childPath(path, key); !removed[p] {
+				pruned[key] = prune(p, element, removed)
+			}
+		}
+		return pruned
+	case []interface{}:
+		pruned := make([]interface{}, 0, len(v))
+		for i, element := range v {
+			if p := indexPath(path, i); !removed[p] {
+				pruned = append(pruned, prune(p, element, removed))
+			}
+		}
+		return pruned
+	}
+	return value
+}
+
+// replacePaths returns a copy of value where the values selected by the expression are replaced by replacement
+func replacePaths(value interface{}, expression string, replacement interface{}) (interface{}, error) {
+	matches, err := selectPaths(value, expression)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		if len(m.location) == 0 {
+			return replacement, nil
+		}
+		value = replace(value, m.location, replacement)
+	}
+	return value, nil
+}
+
+func replace(value interface{}, location []interface{}, replacement interface{}) interface{} {
+	if len(location) == 0 {
+		return replacement
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		replaced := make(map[string]interface{}, len(v))
+		for key, element := range v {
+			replaced[key] = element
+		}
+		key := location[0].(string)
+		replaced[key] = replace(v[key], location[1:], replacement)
+		return replaced
+	case []interface{}:
+		replaced := make([]interface{}, len(v))
+		copy(replaced, v)
+		i := location[0].(int)
+		replaced[i] = replace(v[i], location[1:], replacement)
+		return replaced
+	}
+	return value
+}
@@ -0,0 +1,124 @@
+package jsonpath
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// CompilePattern compiles a regular expression for use with Matches, MatchesAll, MatchesAny and MatchesAndCapture
+func CompilePattern(expr string) (*regexp.Regexp, error) {
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, &SetupError{Err: fmt.Errorf("invalid pattern: '%s'", expr)}
+	}
+	return pattern, nil
+}
+
+func Matches(expression string, pattern *regexp.Regexp, data io.Reader) error {
+	value, err := JsonPath(data, expression)
+	if isSetupError(err) {
+		return err
+	}
+	if value == nil {
+		return fmt.Errorf("no match for pattern: '%s'", expression)
+	}
+	return matchValue(pattern, value)
+}
+
+// MatchesAndCapture is like Matches and on success stores the named groups of the pattern in captures, which must not
+// be nil
+func MatchesAndCapture(expression string, pattern *regexp.Regexp, captures map[string]string, data io.Reader) error {
+	if captures == nil {
+		return &SetupError{Err: fmt.Errorf("captures map for \"%s\" is nil", expression)}
+	}
+	value, err := JsonPath(data, expression)
+	if isSetupError(err) {
+		return err
+	}
+	if value == nil {
+		return fmt.Errorf("no match for pattern: '%s'", expression)
+	}
+	if err := matchValue(pattern, value); err != nil {
+		return err
+	}
+
+	submatches := pattern.FindStringSubmatch(fmt.Sprintf("%v", value))
+	for i, name := range pattern.SubexpNames() {
+		if name != "" {
+			captures[name] = submatches[i]
+		}
+	}
+	return nil
+}
+
+func MatchesAll(expression string, pattern *regexp.Regexp, data io.Reader) error {
+	elements, errs, err := matchElements(expression, pattern, data)
+	if err != nil {
+		return err
+	}
+	if failures := describeFailures(elements, errs); len(failures) > 0 {
+		return fmt.Errorf("%d of %d elements of \"%s\" do not match pattern '%s':\n%s", len(failures), len(elements), expression, pattern, strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+func MatchesAny(expression string, pattern *regexp.Regexp, data io.Reader) error {
+	elements, errs, err := matchElements(expression, pattern, data)
+	if err != nil {
+		return err
+	}
+	if len(elements) == 0 {
+		return fmt.Errorf("no elements of \"%s\" match pattern '%s': \"%s\" is empty", expression, pattern, expression)
+	}
+	if failures := describeFailures(elements, errs); len(failures) == len(elements) {
+		return fmt.Errorf("no elements of \"%s\" match pattern '%s':\n%s", expression, pattern, strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+func matchElements(expression string, pattern *regexp.Regexp, data io.Reader) ([]Element, []error, error) {
+	elements, err := Elements(expression, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	errs := make([]error, len(elements))
+	for i, element := range elements {
+		if element.Value == nil {
+			errs[i] = fmt.Errorf("value is null")
+			continue
+		}
+		errs[i] = matchValue(pattern, element.Value)
+	}
+	return elements, errs, nil
+}
+
+// matchValue matches the text of a string, number or boolean against the pattern
+func matchValue(pattern *regexp.Regexp, value interface{}) error {
+	kind := reflect.ValueOf(value).Kind()
+	switch kind {
+	case reflect.Bool,
+		reflect.Int,
+		reflect.Int8,
+		reflect.Int16,
+		reflect.Int32,
+		reflect.Int64,
+		reflect.Uint,
+		reflect.Uint8,
+		reflect.Uint16,
+		reflect.Uint32,
+		reflect.Uint64,
+		reflect.Uintptr,
+		reflect.Float32,
+		reflect.Float64,
+		reflect.String:
+		if !pattern.MatchString(fmt.Sprintf("%v", value)) {
+			return fmt.Errorf("value '%v' does not match pattern '%v'", value, pattern)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unable to match using type: %s", kind.String())
+	}
+}
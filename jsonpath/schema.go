@@ -0,0 +1,105 @@
+package jsonpath
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const inlineSchemaURL = "inline://apitest-jsonpath/schema.json"
+
+// Schema is a compiled JSON Schema
+type Schema struct {
+	schema *jsonschema.Schema
+}
+
+// CompileSchema compiles a JSON Schema given as a string or []byte containing the schema document, or as a string
+// containing the path of a local schema file. Draft 2020-12 is used unless the schema declares another "$schema".
+func CompileSchema(schema interface{}) (*Schema, error) {
+	var document []byte
+	switch s := schema.(type) {
+	case string:
+		if !isInlineSchema(s) {
+			return compileSchemaFile(s)
+		}
+		document = []byte(s)
+	case []byte:
+		document = s
+	default:
+		return nil, fmt.Errorf("unsupported schema type: %T", schema)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(inlineSchemaURL, bytes.NewReader(document)); err != nil {
+		return nil, fmt.Errorf("invalid schema: %s", err)
+	}
+	compiled, err := compiler.Compile(inlineSchemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %s", err)
+	}
+	return &Schema{schema: compiled}, nil
+}
+
+func compileSchemaFile(path string) (*Schema, error) {
+	compiled, err := jsonschema.NewCompiler().Compile(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema '%s': %s", path, err)
+	}
+	return &Schema{schema: compiled}, nil
+}
+
+func isInlineSchema(schema string) bool {
+	trimmed := strings.TrimSpace(schema)
+	return strings.HasPrefix(trimmed, "{") || trimmed == "true" || trimmed == "false"
+}
+
+func MatchesSchema(expression string, schema *Schema, data io.Reader) error {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return err
+	}
+
+	err = schema.schema.Validate(value)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	leaves := schemaViolations(validationErr)
+	locations := make(map[*jsonschema.ValidationError]string, len(leaves))
+	for _, leaf := range leaves {
+		locations[leaf] = pointerToPath(expression, value, leaf.InstanceLocation)
+	}
+	sort.SliceStable(leaves, func(i, j int) bool {
+		if locations[leaves[i]] != locations[leaves[j]] {
+			return locations[leaves[i]] < locations[leaves[j]]
+		}
+		return leaves[i].Message < leaves[j].Message
+	})
+
+	violations := make([]string, len(leaves))
+	for i, leaf := range leaves {
+		violations[i] = fmt.Sprintf("%s: %s", locations[leaf], leaf.Message)
+	}
+	return fmt.Errorf("\"%s\" does not match schema:\n  %s", expression, strings.Join(violations, "\n  "))
+}
+
+func schemaViolations(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+	var leaves []*jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		leaves = append(leaves, schemaViolations(cause)...)
+	}
+	return leaves
+}
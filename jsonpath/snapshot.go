@@ -0,0 +1,82 @@
+package jsonpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// UpdateSnapshotsEnv is the environment variable which, when set to true, makes MatchesSnapshot write the snapshot
+// instead of comparing against it
+const UpdateSnapshotsEnv = "UPDATE_SNAPSHOTS"
+
+// Redaction replaces the values selected by Expression with Placeholder before a snapshot is compared or written
+type Redaction struct {
+	Expression  string
+	Placeholder interface{}
+}
+
+func MatchesSnapshot(expression string, file string, redactions []Redaction, data io.Reader) error {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return err
+	}
+
+	for _, redaction := range redactions {
+		value, err = replacePaths(value, redaction.Expression, redaction.Placeholder)
+		if err != nil {
+			return err
+		}
+	}
+
+	if update, _ := strconv.ParseBool(os.Getenv(UpdateSnapshotsEnv)); update {
+		return writeSnapshot(file, value)
+	}
+
+	b, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("snapshot '%s' does not exist, run the tests with %s=true to create it", file, UpdateSnapshotsEnv)
+	}
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := decode(bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("invalid snapshot '%s': %s", file, err)
+	}
+
+	if !ObjectsAreEqual(snapshot, value) {
+		return fmt.Errorf("\"%s\" does not match snapshot '%s', run the tests with %s=true to update it\n%s",
+			expression, file, UpdateSnapshotsEnv, describeDiff(expression, snapshot, value))
+	}
+	return nil
+}
+
+func writeSnapshot(file string, value interface{}) error {
+	b, err := formatSnapshot(value)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, b, 0644)
+}
+
+// formatSnapshot renders value as indented JSON. Object keys are sorted by encoding/json, which keeps diffs stable
+func formatSnapshot(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,131 @@
+package jsonpath
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type Direction int
+
+const (
+	Ascending Direction = iota
+	Descending
+)
+
+func (d Direction) String() string {
+	if d == Descending {
+		return "descending"
+	}
+	return "ascending"
+}
+
+// SortKey describes one key of a sort order. Expression is evaluated against each element, e.g. `$.createdAt`
+type SortKey struct {
+	Expression string
+	Direction  Direction
+	IgnoreCase bool
+}
+
+func SortedBy(expression string, keys []SortKey, data io.Reader) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("no sort keys given for \"%s\"", expression)
+	}
+
+	elements, err := Elements(expression, data)
+	if err != nil {
+		return err
+	}
+
+	values := make([][]interface{}, len(elements))
+	for i, element := range elements {
+		for _, key := range keys {
+			value, err := get(element.Value, key.Expression)
+			if err != nil {
+				return fmt.Errorf("%s: %s", element.Path, err)
+			}
+			values[i] = append(values[i], value)
+		}
+	}
+
+	for i := 1; i < len(elements); i++ {
+		for k, key := range keys {
+			c, err := compareSortValues(values[i-1][k], values[i][k], key.IgnoreCase)
+			if err != nil {
+				return fmt.Errorf("cannot compare %s and %s by %s: %s", elements[i-1].Path, elements[i].Path, key.Expression, err)
+			}
+			if key.Direction == Descending {
+				c = -c
+			}
+			if c < 0 {
+				break
+			}
+			if c > 0 {
+				return fmt.Errorf("\"%s\" is not sorted by %s: %s (%s) should not come before %s (%s)",
+					expression, describeSortKeys(keys), elements[i-1].Path, formatSortValues(values[i-1]), elements[i].Path, formatSortValues(values[i]))
+			}
+		}
+	}
+	return nil
+}
+
+// compareSortValues compares numbers by value, RFC 3339 timestamps by time and other strings lexically
+func compareSortValues(a, b interface{}, ignoreCase bool) (int, error) {
+	if x, ok := a.(string); ok {
+		y, ok := b.(string)
+		if !ok {
+			return 0, fmt.Errorf("%s has type %s, %s has type %s", formatValue(a), jsonType(a), formatValue(b), jsonType(b))
+		}
+		if t1, err := time.Parse(time.RFC3339Nano, x); err == nil {
+			if t2, err := time.Parse(time.RFC3339Nano, y); err == nil {
+				return compareTimes(t1, t2), nil
+			}
+		}
+		if ignoreCase {
+			x, y = strings.ToLower(x), strings.ToLower(y)
+		}
+		return strings.Compare(x, y), nil
+	}
+
+	if jsonType(a) == typeNumber && jsonType(b) == typeNumber {
+		x, _ := toNumber(a)
+		y, _ := toNumber(b)
+		switch {
+		case x < y:
+			return -1, nil
+		case x > y:
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, fmt.Errorf("%s has type %s, %s has type %s", formatValue(a), jsonType(a), formatValue(b), jsonType(b))
+}
+
+func compareTimes(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
+
+func describeSortKeys(keys []SortKey) string {
+	descriptions := make([]string, len(keys))
+	for i, key := range keys {
+		descriptions[i] = key.Expression + " " + key.Direction.String()
+		if key.IgnoreCase {
+			descriptions[i] += " ignoring case"
+		}
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func formatSortValues(values []interface{}) string {
+	if len(values) == 1 {
+		return formatValue(values[0])
+	}
+	return formatValue(values)
+}
@@ -0,0 +1,109 @@
+package jsonpath
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func HasPrefix(expression string, prefix string, data io.Reader) error {
+	value, err := stringValue(expression, data)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(value, prefix) {
+		return fmt.Errorf("\"%s\" value %s does not start with %s", expression, formatValue(value), formatValue(prefix))
+	}
+	return nil
+}
+
+func HasSuffix(expression string, suffix string, data io.Reader) error {
+	value, err := stringValue(expression, data)
+	if err != nil {
+		return err
+	}
+	if !strings.HasSuffix(value, suffix) {
+		return fmt.Errorf("\"%s\" value %s does not end with %s", expression, formatValue(value), formatValue(suffix))
+	}
+	return nil
+}
+
+func ContainsString(expression string, substring string, data io.Reader) error {
+	value, err := stringValue(expression, data)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(value, substring) {
+		return fmt.Errorf("\"%s\" value %s does not contain %s", expression, formatValue(value), formatValue(substring))
+	}
+	return nil
+}
+
+func EqualFold(expression string, expected string, data io.Reader) error {
+	value, err := stringValue(expression, data)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(value, expected) {
+		return fmt.Errorf("\"%s\" value %s is not equal to %s ignoring case", expression, formatValue(value), formatValue(expected))
+	}
+	return nil
+}
+
+// EqualNormalized compares strings after converting both to Unicode Normalization Form C, so that precomposed and
+// decomposed forms of the same characters are equal
+func EqualNormalized(expression string, expected string, data io.Reader) error {
+	value, err := stringValue(expression, data)
+	if err != nil {
+		return err
+	}
+	if norm.NFC.String(value) != norm.NFC.String(expected) {
+		return fmt.Errorf("\"%s\" value %s is not equal to %s after Unicode normalization", expression, formatValue(value), formatValue(expected))
+	}
+	return nil
+}
+
+func RuneLen(expression string, expectedLength int, data io.Reader) error {
+	return compareRuneLen(expression, data, fmt.Sprintf("%d", expectedLength), func(n int) bool {
+		return n == expectedLength
+	})
+}
+
+func MinRuneLen(expression string, minLength int, data io.Reader) error {
+	return compareRuneLen(expression, data, fmt.Sprintf("at least %d", minLength), func(n int) bool {
+		return n >= minLength
+	})
+}
+
+func MaxRuneLen(expression string, maxLength int, data io.Reader) error {
+	return compareRuneLen(expression, data, fmt.Sprintf("at most %d", maxLength), func(n int) bool {
+		return n <= maxLength
+	})
+}
+
+func compareRuneLen(expression string, data io.Reader, description string, compare func(int) bool) error {
+	value, err := stringValue(expression, data)
+	if err != nil {
+		return err
+	}
+	n := utf8.RuneCountInString(value)
+	if !compare(n) {
+		return fmt.Errorf("\"%s\" has %d runes, expected %s", expression, n, description)
+	}
+	return nil
+}
+
+func stringValue(expression string, data io.Reader) (string, error) {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return "", err
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("\"%s\" has type %s, expected %s", expression, jsonType(value), typeString)
+	}
+	return s, nil
+}
@@ -0,0 +1,97 @@
+package jsonpath
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+func EqualSubset(expression string, expected interface{}, data io.Reader) error {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return err
+	}
+
+	subset, err := toJSON(expected)
+	if err != nil {
+		return err
+	}
+
+	if mismatches := subsetMismatches(expression, subset, value); len(mismatches) > 0 {
+		return fmt.Errorf("\"%s\" does not match subset:\n  %s", expression, strings.Join(mismatches, "\n  "))
+	}
+	return nil
+}
+
+func ContainsSubset(expression string, expected interface{}, data io.Reader) error {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return err
+	}
+
+	elements, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("\"%s\" has type %s, expected %s", expression, jsonType(value), typeArray)
+	}
+
+	subset, err := toJSON(expected)
+	if err != nil {
+		return err
+	}
+
+	for i, element := range elements {
+		if len(subsetMismatches(indexPath(expression, i), subset, element)) == 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("\"%s\" does not contain an element matching subset %s", expression, formatValue(subset))
+}
+
+// subsetMismatches lists the paths where actual does not match expected. Objects in expected only need to describe
+// the keys that must match, arrays must have the same length and match element by element
+func subsetMismatches(path string, expected, actual interface{}) []string {
+	switch exp := expected.(type) {
+	case map[string]interface{}:
+		act, ok := actual.(map[string]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected %s but was %s", path, typeObject, jsonType(actual))}
+		}
+
+		keys := make([]string, 0, len(exp))
+		for key := range exp {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var mismatches []string
+		for _, key := range keys {
+			value, ok := act[key]
+			if !ok {
+				mismatches = append(mismatches, fmt.Sprintf("%s: missing", childPath(path, key)))
+				continue
+			}
+			mismatches = append(mismatches, subsetMismatches(childPath(path, key), exp[key], value)...)
+		}
+		return mismatches
+	case []interface{}:
+		act, ok := actual.([]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected %s but was %s", path, typeArray, jsonType(actual))}
+		}
+		if len(exp) != len(act) {
+			return []string{fmt.Sprintf("%s: expected %d elements but was %d", path, len(exp), len(act))}
+		}
+
+		var mismatches []string
+		for i := range exp {
+			mismatches = append(mismatches, subsetMismatches(indexPath(path, i), exp[i], act[i])...)
+		}
+		return mismatches
+	default:
+		if !ObjectsAreEqual(expected, actual) {
+			return []string{fmt.Sprintf("%s: expected %s but was %s", path, formatValue(expected), formatValue(actual))}
+		}
+		return nil
+	}
+}
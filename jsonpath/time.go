@@ -0,0 +1,141 @@
+package jsonpath
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// UnixSeconds is a layout for timestamps given as the number of seconds since the Unix epoch
+	UnixSeconds = "unix"
+	// UnixMillis is a layout for timestamps given as the number of milliseconds since the Unix epoch
+	UnixMillis = "unixmillis"
+)
+
+// defaultLayouts are tried in order when no layouts are given. Numbers are treated as Unix seconds or milliseconds
+var defaultLayouts = []string{time.RFC3339Nano, time.RFC1123Z, time.RFC1123}
+
+// unixMillisThreshold separates Unix seconds from Unix milliseconds when no layout is given. As seconds it is in the
+// year 5138 and as milliseconds in 1973
+const unixMillisThreshold = 1e11
+
+func Before(expression string, reference time.Time, layouts []string, data io.Reader) error {
+	t, err := timestamp(expression, layouts, data)
+	if err != nil {
+		return err
+	}
+	if !t.Before(reference) {
+		return fmt.Errorf("\"%s\" %s is not before %s", expression, formatTime(t), formatTime(reference))
+	}
+	return nil
+}
+
+func After(expression string, reference time.Time, layouts []string, data io.Reader) error {
+	t, err := timestamp(expression, layouts, data)
+	if err != nil {
+		return err
+	}
+	if !t.After(reference) {
+		return fmt.Errorf("\"%s\" %s is not after %s", expression, formatTime(t), formatTime(reference))
+	}
+	return nil
+}
+
+func WithinDuration(expression string, reference time.Time, delta time.Duration, layouts []string, data io.Reader) error {
+	t, err := timestamp(expression, layouts, data)
+	if err != nil {
+		return err
+	}
+	difference := t.Sub(reference)
+	if difference < -delta || difference > delta {
+		return fmt.Errorf("\"%s\" %s is not within %s of %s, difference %s", expression, formatTime(t), delta, formatTime(reference), difference)
+	}
+	return nil
+}
+
+func timestamp(expression string, layouts []string, data io.Reader) (time.Time, error) {
+	value, err := JsonPath(data, expression)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parseTime(value, layouts)
+}
+
+// parseTime parses a string or number using the first layout that matches. Without layouts the default layouts are
+// used for strings and numbers are treated as Unix seconds or milliseconds depending on their magnitude
+func parseTime(value interface{}, layouts []string) (time.Time, error) {
+	if len(layouts) == 0 {
+		if jsonType(value) == typeNumber {
+			n, _ := toNumber(value)
+			if math.Abs(n) >= unixMillisThreshold {
+				return fromUnix(n, time.Millisecond), nil
+			}
+			return fromUnix(n, time.Second), nil
+		}
+		layouts = defaultLayouts
+	}
+
+	for _, layout := range layouts {
+		switch layout {
+		case UnixSeconds, UnixMillis:
+			n, ok := toNumber(value)
+			if !ok {
+				continue
+			}
+			if layout == UnixMillis {
+				return fromUnix(n, time.Millisecond), nil
+			}
+			return fromUnix(n, time.Second), nil
+		default:
+			s, ok := value.(string)
+			if !ok {
+				continue
+			}
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("%s is not a timestamp matching %s", formatValue(value), describeLayouts(layouts))
+}
+
+func fromUnix(n float64, unit time.Duration) time.Time {
+	whole, fraction := math.Modf(n)
+	if unit == time.Millisecond {
+		millis := int64(whole)
+		return time.Unix(millis/1000, (millis%1000)*int64(time.Millisecond)+int64(fraction*float64(time.Millisecond))).UTC()
+	}
+	return time.Unix(int64(whole), int64(fraction*float64(time.Second))).UTC()
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+func describeLayouts(layouts []string) string {
+	names := map[string]string{
+		time.RFC3339Nano: "RFC 3339",
+		time.RFC3339:     "RFC 3339",
+		time.RFC1123Z:    "RFC 1123",
+		time.RFC1123:     "RFC 1123",
+		UnixSeconds:      "Unix seconds",
+		UnixMillis:       "Unix milliseconds",
+	}
+	var descriptions []string
+	seen := make(map[string]bool)
+	for _, layout := range layouts {
+		description, ok := names[layout]
+		if !ok {
+			description = strconv.Quote(layout)
+		}
+		if !seen[description] {
+			seen[description] = true
+			descriptions = append(descriptions, description)
+		}
+	}
+	return strings.Join(descriptions, ", ")
+}
@@ -0,0 +1,166 @@
+package jsonpath
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func InDelta(expression string, expected interface{}, delta float64, data io.Reader) error {
+	if math.IsNaN(delta) || delta < 0 {
+		return fmt.Errorf("delta must be a non-negative number but was %s", formatNumber(delta))
+	}
+	description := fmt.Sprintf("within delta %s", formatNumber(delta))
+	return withinTolerance(expression, expected, description, data, func(exp, act float64) (string, bool) {
+		difference := math.Abs(exp - act)
+		return "difference " + formatTolerance(difference), difference <= delta
+	})
+}
+
+func InEpsilon(expression string, expected interface{}, epsilon float64, data io.Reader) error {
+	if math.IsNaN(epsilon) || epsilon < 0 {
+		return fmt.Errorf("epsilon must be a non-negative number but was %s", formatNumber(epsilon))
+	}
+	description := fmt.Sprintf("within epsilon %s", formatNumber(epsilon))
+	return withinTolerance(expression, expected, description, data, func(exp, act float64) (string, bool) {
+		if exp == 0 {
+			return "relative error is undefined for an expected value of 0", act == 0
+		}
+		relative := math.Abs(exp-act) / math.Abs(exp)
+		return "relative error " + formatTolerance(relative), relative <= epsilon
+	})
+}
+
+// tolerance reports whether act is close enough to exp, together with a description of how far apart they are
+type tolerance func(exp, act float64) (string, bool)
+
+func withinTolerance(expression string, expected interface{}, description string, data io.Reader, within tolerance) error {
+	doc, err := decode(data)
+	if err != nil {
+		return err
+	}
+
+	exp, err := toJSON(expected)
+	if err != nil {
+		return err
+	}
+
+	var mismatches []string
+	if steps, err := parseSteps(expression); err == nil && !definite(steps) {
+		mismatches, err = elementToleranceMismatches(doc, expression, exp, within)
+		if err != nil {
+			return err
+		}
+	} else {
+		value, err := get(doc, expression)
+		if err != nil {
+			return err
+		}
+		mismatches = toleranceMismatches(expression, exp, value, within)
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("\"%s\" is not %s of expected value:\n  %s", expression, description, strings.Join(mismatches, "\n  "))
+	}
+	return nil
+}
+
+// elementToleranceMismatches compares the values selected by a wildcard expression with the elements of expected and
+// reports each mismatch with the path of the selected value, e.g. $.items[1].price
+func elementToleranceMismatches(doc interface{}, expression string, expected interface{}, within tolerance) ([]string, error) {
+	elements, err := selectElements(doc, expression)
+	if err != nil {
+		return nil, err
+	}
+
+	exp, ok := expected.([]interface{})
+	if !ok {
+		return []string{fmt.Sprintf("%s: expected %s but was %s", expression, jsonType(expected), typeArray)}, nil
+	}
+	if len(exp) != len(elements) {
+		return []string{fmt.Sprintf("%s: expected %d elements but was %d", expression, len(exp), len(elements))}, nil
+	}
+
+	var mismatches []string
+	for i, element := range elements {
+		mismatches = append(mismatches, toleranceMismatches(element.Path, exp[i], element.Value, within)...)
+	}
+	return mismatches, nil
+}
+
+// toleranceMismatches lists the paths where actual does not match expected. Numbers only need to be within the
+// tolerance, all other values must be equal and objects and arrays are compared element by element
+func toleranceMismatches(path string, expected, actual interface{}, within tolerance) []string {
+	switch exp := expected.(type) {
+	case map[string]interface{}:
+		act, ok := actual.(map[string]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected %s but was %s", path, typeObject, jsonType(actual))}
+		}
+
+		keys := make([]string, 0, len(exp)+len(act))
+		for key := range exp {
+			keys = append(keys, key)
+		}
+		for key := range act {
+			if _, ok := exp[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		var mismatches []string
+		for _, key := range keys {
+			e, inExpected := exp[key]
+			a, inActual := act[key]
+			switch {
+			case !inActual:
+				mismatches = append(mismatches, fmt.Sprintf("%s: missing", childPath(path, key)))
+			case !inExpected:
+				mismatches = append(mismatches, fmt.Sprintf("%s: not expected", childPath(path, key)))
+			default:
+				mismatches = append(mismatches, toleranceMismatches(childPath(path, key), e, a, within)...)
+			}
+		}
+		return mismatches
+	case []interface{}:
+		act, ok := actual.([]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected %s but was %s", path, typeArray, jsonType(actual))}
+		}
+		if len(exp) != len(act) {
+			return []string{fmt.Sprintf("%s: expected %d elements but was %d", path, len(exp), len(act))}
+		}
+
+		var mismatches []string
+		for i := range exp {
+			mismatches = append(mismatches, toleranceMismatches(indexPath(path, i), exp[i], act[i], within)...)
+		}
+		return mismatches
+	}
+
+	if jsonType(expected) == typeNumber {
+		if jsonType(actual) != typeNumber {
+			return []string{fmt.Sprintf("%s: expected %s but was %s", path, typeNumber, jsonType(actual))}
+		}
+		e, _ := toNumber(expected)
+		a, _ := toNumber(actual)
+		if difference, ok := within(e, a); !ok {
+			return []string{fmt.Sprintf("%s: expected %s but was %s, %s", path, formatNumber(e), formatNumber(a), difference)}
+		}
+		return nil
+	}
+
+	if !ObjectsAreEqual(expected, actual) {
+		return []string{fmt.Sprintf("%s: expected %s but was %s", path, formatValue(expected), formatValue(actual))}
+	}
+	return nil
+}
+
+// formatTolerance rounds a difference to a readable number of significant digits
+func formatTolerance(n float64) string {
+	return strconv.FormatFloat(n, 'g', 6, 64)
+}
@@ -0,0 +1,71 @@
+package jsonpath
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+func Unique(expression string, data io.Reader) error {
+	return unique(expression, "", data)
+}
+
+func UniqueBy(expression string, keyExpression string, data io.Reader) error {
+	return unique(expression, keyExpression, data)
+}
+
+// duplicate is a value together with the indices of the elements it was found at
+type duplicate struct {
+	value   interface{}
+	indices []int
+}
+
+func unique(expression string, keyExpression string, data io.Reader) error {
+	elements, err := Elements(expression, data)
+	if err != nil {
+		return err
+	}
+
+	var groups []*duplicate
+	for i, element := range elements {
+		value := element.Value
+		if keyExpression != "" {
+			value, err = get(element.Value, keyExpression)
+			if err != nil {
+				return fmt.Errorf("%s: %s", element.Path, err)
+			}
+		}
+		groups = addToGroup(groups, value, i)
+	}
+
+	var duplicates []string
+	for _, group := range groups {
+		if len(group.indices) < 2 {
+			continue
+		}
+		indices := make([]string, len(group.indices))
+		for i, index := range group.indices {
+			indices[i] = strconv.Itoa(index)
+		}
+		duplicates = append(duplicates, fmt.Sprintf("%s at indices %s", formatValue(group.value), strings.Join(indices, ", ")))
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	if keyExpression == "" {
+		return fmt.Errorf("\"%s\" contains duplicate values:\n  %s", expression, strings.Join(duplicates, "\n  "))
+	}
+	return fmt.Errorf("\"%s\" contains duplicate values of %s:\n  %s", expression, keyExpression, strings.Join(duplicates, "\n  "))
+}
+
+func addToGroup(groups []*duplicate, value interface{}, index int) []*duplicate {
+	for _, group := range groups {
+		if ObjectsAreEqual(group.value, value) {
+			group.indices = append(group.indices, index)
+			return groups
+		}
+	}
+	return append(groups, &duplicate{value: value, indices: []int{index}})
+}
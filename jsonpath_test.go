@@ -2,6 +2,7 @@ package jsonpath_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -90,6 +91,317 @@ func TestApiTest_Equal_Map(t *testing.T) {
 		End()
 }
 
+func TestApiTest_Equal_GoNumericTypes(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"a": 12345, "b": [1, 2, 3], "c": {"d": 1.5, "e": [{"f": 7}]}, "g": "12345"}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.Equal(`$.a`, 12345)).
+		Assert(jsonpath.Equal(`$.a`, int64(12345))).
+		Assert(jsonpath.Equal(`$.a`, uint(12345))).
+		Assert(jsonpath.Equal(`$.a`, json.Number("12345"))).
+		Assert(jsonpath.Equal(`$.b`, []int{1, 2, 3})).
+		Assert(jsonpath.Equal(`$.c`, map[string]interface{}{"d": 1.5, "e": []interface{}{map[string]int{"f": 7}}})).
+		Assert(jsonpath.Contains(`$.b`, 2)).
+		Assert(jsonpath.NotEqual(`$.a`, "12345")).
+		Assert(jsonpath.NotEqual(`$.g`, 12345)).
+		Assert(jsonpath.NotEqual(`$.b`, []int{1, 2})).
+		End()
+}
+
+func TestApiTest_Equal_Decimals(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"price": 0.1, "total": 19.99, "prices": [0.1, 19.99]}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.Equal(`$.price`, 0.1)).
+		Assert(jsonpath.Equal(`$.price`, json.Number("0.1"))).
+		Assert(jsonpath.Equal(`$.price`, float32(0.1))).
+		Assert(jsonpath.Equal(`$.total`, json.Number("19.99"))).
+		Assert(jsonpath.Equal(`$.prices`, []json.Number{"0.1", "19.99"})).
+		Assert(jsonpath.Contains(`$.prices`, json.Number("19.99"))).
+		Assert(jsonpath.NotEqual(`$.total`, json.Number("19.9"))).
+		End()
+}
+
+type user struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Email    string   `json:"email,omitempty"`
+	Role     role     `json:"role"`
+	Internal string   `json:"-"`
+	Tags     []string `json:"tags,omitempty"`
+}
+
+type role int
+
+func (r role) MarshalJSON() ([]byte, error) {
+	if r == 1 {
+		return []byte(`"admin"`), nil
+	}
+	return []byte(`"user"`), nil
+}
+
+func TestApiTest_Equal_Struct(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"user": {"id": "1", "name": "jon", "role": "admin"}, "users": [{"id": "1", "name": "jon", "role": "admin"}, {"id": "2", "name": "sue", "role": "user", "tags": ["x"]}]}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	jon := user{ID: "1", Name: "jon", Role: 1, Internal: "ignored"}
+	sue := user{ID: "2", Name: "sue", Tags: []string{"x"}}
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.Equal(`$.user`, jon)).
+		Assert(jsonpath.Equal(`$.user`, &jon)).
+		Assert(jsonpath.Equal(`$.users`, []user{jon, sue})).
+		Assert(jsonpath.Contains(`$.users`, sue)).
+		Assert(jsonpath.NotEqual(`$.user`, sue)).
+		Assert(jsonpath.Equal(`$.user.role`, role(1))).
+		End()
+}
+
+type item struct {
+	SKU   string  `json:"sku"`
+	Price float64 `json:"price"`
+}
+
+func TestApiTest_Equal_StructWithFloat(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"item": {"sku": "a-1", "price": 19.99}, "items": [{"sku": "a-1", "price": 19.99}, {"sku": "b-2", "price": 0.1}]}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.Equal(`$.item`, item{SKU: "a-1", Price: 19.99})).
+		Assert(jsonpath.Equal(`$.items`, []item{{SKU: "a-1", Price: 19.99}, {SKU: "b-2", Price: 0.1}})).
+		Assert(jsonpath.Contains(`$.items`, item{SKU: "b-2", Price: 0.1})).
+		Assert(jsonpath.NotEqual(`$.item`, item{SKU: "a-1", Price: 19.9})).
+		End()
+}
+
+func TestApiTest_EqualSubset(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"user": {"id": "1", "name": "jon", "createdAt": "2020-01-01", "address": {"city": "London", "zip": "N1"}, "tags": [{"key": "a", "value": 1}]}, "users": [{"id": "1", "name": "jon"}, {"id": "2", "name": "sue"}]}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.EqualSubset(`$.user`, map[string]interface{}{
+			"name":    "jon",
+			"address": map[string]interface{}{"city": "London"},
+			"tags":    []interface{}{map[string]interface{}{"key": "a"}},
+		})).
+		Assert(jsonpath.ContainsSubset(`$.users`, map[string]interface{}{"name": "sue"})).
+		Assert(jsonpath.ContainsSubset(`$.users`, struct {
+			ID string `json:"id"`
+		}{ID: "2"})).
+		Assert(jsonpath.Chain().EqualSubset("$.user", map[string]interface{}{"id": "1"}).End()).
+		End()
+}
+
+func TestApiTest_EqualSubset_Decimals(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"item": {"sku": "a-1", "price": 0.1}, "items": [{"sku": "a-1", "price": 0.1}, {"sku": "b-2", "price": 19.99}]}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.EqualSubset(`$.item`, map[string]interface{}{"price": 0.1})).
+		Assert(jsonpath.ContainsSubset(`$.items`, map[string]interface{}{"price": 19.99})).
+		Assert(jsonpath.ContainsSubset(`$.items`, item{SKU: "b-2", Price: 19.99})).
+		End()
+}
+
+func TestApiTest_EqualSubset_Failures(t *testing.T) {
+	body := `{"user": {"id": "1", "name": "bob", "address": {"city": "Paris"}, "tags": ["a"]}, "users": [{"id": "1"}]}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.EqualSubset(`$.user`, map[string]interface{}{
+			"name":    "jon",
+			"email":   "jon@example.com",
+			"address": map[string]interface{}{"city": "London"},
+			"tags":    []string{"a", "b"},
+			"x-trace": nil,
+		}), `"$.user" does not match subset:
+  $.user.address.city: expected "London" but was "Paris"
+  $.user.email: missing
+  $.user.name: expected "jon" but was "bob"
+  $.user.tags: expected 2 elements but was 1
+  $.user["x-trace"]: missing`},
+		{jsonpath.EqualSubset(`$.user.name`, map[string]interface{}{"first": "bob"}), `"$.user.name" does not match subset:
+  $.user.name: expected object but was string`},
+		{jsonpath.ContainsSubset(`$.users`, map[string]interface{}{"id": "2"}), `"$.users" does not contain an element matching subset {"id":"2"}`},
+		{jsonpath.ContainsSubset(`$.user`, map[string]interface{}{"id": "1"}), `"$.user" has type object, expected array`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
+
+func TestApiTest_EqualIgnoring(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{
+			"id": "8f14e45f",
+			"createdAt": "2021-03-01T10:00:00Z",
+			"x-request-id": "abc",
+			"items": [
+				{"id": "c9f0f895", "sku": "a-1", "createdAt": "2021-03-01T10:00:01Z", "type": "digital", "price": 3.99},
+				{"id": "45c48cce", "sku": "b-2", "createdAt": "2021-03-01T10:00:02Z", "type": "physical", "price": 10}
+			]
+		}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	expected := map[string]interface{}{
+		"id": "generated",
+		"items": []interface{}{
+			map[string]interface{}{"sku": "a-1", "type": "digital", "price": 1},
+			map[string]interface{}{"sku": "b-2", "type": "physical", "price": 10},
+		},
+	}
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.EqualIgnoring(`$`, expected, `$..createdAt`, `$.id`, `$.items[*].id`, `$["x-request-id"]`, `$.items[?(@.type=="digital")].price`)).
+		Assert(jsonpath.EqualIgnoring(`$.items`, []interface{}{"ignored", map[string]interface{}{"sku": "b-2"}}, `$[0]`, `$[*].id`, `$[*].createdAt`, `$[*].type`, `$[-1:].price`)).
+		Assert(jsonpath.EqualIgnoring(`$.items[1]`, map[string]interface{}{"sku": "b-2"}, `$["id", "createdAt", "type", "price"]`)).
+		Assert(jsonpath.EqualIgnoring(`$.items`, []interface{}{map[string]interface{}{"sku": "a-1"}}, `$..[1]`, `$..["id"]`, `$[?(@.type == "digital")]["createdAt", "type", "price"]`)).
+		Assert(jsonpath.EqualIgnoring(`$.items[0]`, map[string]interface{}{"sku": "a-1", "type": "digital", "price": 3.99}, `$.id`, `$.createdAt`)).
+		Assert(jsonpath.EqualIgnoring(`$.items[0]`, item{SKU: "a-1", Price: 3.99}, `$.id`, `$.createdAt`, `$.type`)).
+		End()
+}
+
+func TestApiTest_EqualIgnoring_Failures(t *testing.T) {
+	body := `{"id": "1", "createdAt": "2021-03-01T10:00:00Z", "name": "jon"}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.EqualIgnoring(`$`, map[string]interface{}{"name": "sue"}, `$.id`, `$.createdAt`), `"$" not equal to expected value
+  expected: {"name":"sue"}
+  actual:   {"name":"jon"}
+  diff:
+    changed $.name: "sue" -> "jon"`},
+		{jsonpath.EqualIgnoring(`$`, map[string]interface{}{"name": "jon"}, `$.id`), `"$" not equal to expected value
+  expected: {"name":"jon"}
+  actual:   {"createdAt":"2021-03-01T10:00:00Z","name":"jon"}
+  diff:
+    added   $.createdAt: "2021-03-01T10:00:00Z"`},
+		{jsonpath.EqualIgnoring(`$`, map[string]interface{}{}, `id`), `invalid expression 'id': must start with '$'`},
+		{jsonpath.EqualIgnoring(`$`, map[string]interface{}{}, `$.items[`), "invalid expression '$.items[': 'parsing error: $.items[\t:1:9 - 1:9 unexpected EOF while scanning extensions'"},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
+
+func TestApiTest_Equal_Failures(t *testing.T) {
+	body := `{"a": 12345, "b": [{"key": "c", "value": "result"}], "user": {"name": "jon", "age": 30, "tags": ["a", "b"], "email": "jon@example.com"}}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.Equal(`$.a`, 1), `"$.a" not equal to expected value
+  expected: 1
+  actual:   12345
+  diff:
+    changed $.a: 1 -> 12345`},
+		{jsonpath.Equal(`$.user`, map[string]interface{}{"name": "sue", "age": 30, "tags": []string{"a"}, "role": "admin"}), `"$.user" not equal to expected value
+  expected: {"age":30,"name":"sue","role":"admin","tags":["a"]}
+  actual:   {"age":30,"email":"jon@example.com","name":"jon","tags":["a","b"]}
+  diff:
+    added   $.user.email: "jon@example.com"
+    changed $.user.name: "sue" -> "jon"
+    removed $.user.role: "admin"
+    added   $.user.tags[1]: "b"`},
+		{jsonpath.NotEqual(`$.a`, 12345), `"$.a" value is equal to 12345`},
+		{jsonpath.Contains(`$.b[*].value`, "other"), `"$.b[*].value" does not contain expected value
+  expected: "other"
+  actual:   ["result"]`},
+		{jsonpath.Contains(`$.a`, 1), `12345 could not be applied builtin len()`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
+
 func TestApiTest_NotEqual_Numeric(t *testing.T) {
 	handler := http.NewServeMux()
 	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
@@ -240,6 +552,105 @@ func TestApiTest_LessThan(t *testing.T) {
 		End()
 }
 
+func TestApiTest_NumberComparisons(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"price": 12.5, "age": 30, "quantity": "7"}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.NumberGreaterThan(`$.price`, 10)).
+		Assert(jsonpath.NumberLessThan(`$.price`, 13)).
+		Assert(jsonpath.NumberAtLeast(`$.age`, 30)).
+		Assert(jsonpath.NumberAtMost(`$.age`, 30)).
+		Assert(jsonpath.NumberBetween(`$.age`, 18, 65)).
+		Assert(jsonpath.NumberGreaterThan(`$.quantity`, 5)).
+		Assert(jsonpath.Root("$").NumberBetween("price", 12, 13).End()).
+		End()
+}
+
+func TestApiTest_NumberComparisons_Failures(t *testing.T) {
+	body := `{"price": 12.5, "age": 30, "name": "jon", "d": null}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.NumberGreaterThan(`$.price`, 12.5), `"12.5" is not greater than "12.5"`},
+		{jsonpath.NumberLessThan(`$.age`, 30), `"30" is not less than "30"`},
+		{jsonpath.NumberAtLeast(`$.age`, 31), `"30" is not at least "31"`},
+		{jsonpath.NumberAtMost(`$.price`, 10), `"12.5" is not at most "10"`},
+		{jsonpath.NumberBetween(`$.age`, 18, 29), `"30" is not between "18" and "29"`},
+		{jsonpath.NumberGreaterThan(`$.name`, 1), `"jon" is not a number`},
+		{jsonpath.NumberGreaterThan(`$.d`, 1), `value is null`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
+
+func TestApiTest_Types(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"id": "1234", "count": 3, "price": 1.5, "active": false, "tags": [], "user": {}, "d": null}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.IsString(`$.id`)).
+		Assert(jsonpath.IsNumber(`$.price`)).
+		Assert(jsonpath.IsInteger(`$.count`)).
+		Assert(jsonpath.IsBool(`$.active`)).
+		Assert(jsonpath.IsArray(`$.tags`)).
+		Assert(jsonpath.IsObject(`$.user`)).
+		Assert(jsonpath.IsNull(`$.d`)).
+		Assert(jsonpath.Chain().IsString("$.id").IsInteger("$.count").End()).
+		End()
+}
+
+func TestApiTest_Types_Failures(t *testing.T) {
+	body := `{"id": 1234, "price": 1.5, "active": "false", "tags": {}, "d": null}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.IsString(`$.id`), `"$.id" has type number, expected string`},
+		{jsonpath.IsInteger(`$.price`), `"$.price" has type number, expected integer`},
+		{jsonpath.IsNumber(`$.d`), `"$.d" has type null, expected number`},
+		{jsonpath.IsBool(`$.active`), `"$.active" has type string, expected boolean`},
+		{jsonpath.IsArray(`$.tags`), `"$.tags" has type object, expected array`},
+		{jsonpath.IsObject(`$.id`), `"$.id" has type number, expected object`},
+		{jsonpath.IsNull(`$.id`), `"$.id" has type number, expected null`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
+
 func TestApiTest_Present(t *testing.T) {
 	handler := http.NewServeMux()
 	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
@@ -260,6 +671,81 @@ func TestApiTest_Present(t *testing.T) {
 		End()
 }
 
+func TestApiTest_Exists(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"count": 0, "active": false, "name": "", "d": null, "tags": [], "items": [{"sku": "a"}, {"sku": "b", "price": null}]}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.Exists(`$.count`)).
+		Assert(jsonpath.Exists(`$.d`)).
+		Assert(jsonpath.Exists(`$.tags`)).
+		Assert(jsonpath.Exists(`$.items[*].sku`)).
+		Assert(jsonpath.Exists(`$.items[*].price`)).
+		Assert(jsonpath.Exists(`$..price`)).
+		Assert(jsonpath.NotExists(`$.password`)).
+		Assert(jsonpath.NotExists(`$.tags[*]`)).
+		Assert(jsonpath.NotExists(`$.items[*].password`)).
+		Assert(jsonpath.NotExists(`$..password`)).
+		Assert(jsonpath.NotNull(`$.active`)).
+		Assert(jsonpath.NotEmpty(`$.count`)).
+		Assert(jsonpath.NotEmpty(`$.active`)).
+		Assert(jsonpath.IsNull(`$.d`)).
+		End()
+}
+
+func TestApiTest_Exists_Failures(t *testing.T) {
+	body := `{"count": 0, "name": "", "tags": [], "d": null}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.Exists(`$.password`), `value does not exist for expression: '$.password'`},
+		{jsonpath.Exists(`$.tags[*]`), `value does not exist for expression: '$.tags[*]'`},
+		{jsonpath.Exists(`$..password`), `value does not exist for expression: '$..password'`},
+		{jsonpath.NotExists(`$.tags`), `value exists for expression: '$.tags'`},
+		{jsonpath.NotExists(`$.d`), `value exists for expression: '$.d'`},
+		{jsonpath.NotNull(`$.d`), `value is null for expression: '$.d'`},
+		{jsonpath.NotNull(`$.password`), `evaluating '$.password' resulted in error: 'unknown key password'`},
+		{jsonpath.NotEmpty(`$.name`), `value is empty for expression: '$.name'`},
+		{jsonpath.NotEmpty(`$.tags`), `value is empty for expression: '$.tags'`},
+		{jsonpath.IsNull(`$.password`), `evaluating '$.password' resulted in error: 'unknown key password'`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
+
+func TestApiTest_NotPresent_EmptyBody(t *testing.T) {
+	err := jsonpath.NotPresent(`$.error`)(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewBuffer(nil)),
+	}, nil)
+
+	assert.NoError(t, err)
+}
+
+func TestApiTest_NotExists_InvalidBody(t *testing.T) {
+	err := jsonpath.NotExists(`$.password`)(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewBuffer([]byte(`not json`))),
+	}, nil)
+
+	assert.Error(t, err)
+}
+
 func TestApiTest_Matches(t *testing.T) {
 	testCases := [][]string{
 		{`$.aString`, `^[mot]{3}<3[AB][re]{3}$`},
@@ -339,6 +825,163 @@ func TestApiTest_Chain(t *testing.T) {
 		End()
 }
 
+func TestApiTest_Root_PathJoining(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{
+			"order": {
+				"x-id": "o-1",
+				"customer": {"name": "jon", "address": {"city": "Berlin"}},
+				"lines": [{"sku": "a-1", "quantity": 2}, {"sku": "b-2", "quantity": 1}]
+			}
+		}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(
+			jsonpath.Root("$.order").
+				Equal("['x-id']", "o-1").
+				Equal(`["x-id"]`, "o-1").
+				Equal("lines[0].sku", "a-1").
+				Equal("$.customer.name", "jon").
+				Len("$.lines", 2).
+				Equal("..city", []interface{}{"Berlin"}).
+				Object("customer", func(c *jsonpath.AssertionChain) {
+					c.Equal("name", "jon").
+						Object("address", func(c *jsonpath.AssertionChain) {
+							c.Equal("city", "Berlin")
+						})
+				}).
+				Array("lines", func(c *jsonpath.AssertionChain) {
+					c.Equal("[0].sku", "a-1").
+						Equal("[1].quantity", 1).
+						Len("$", 2)
+				}).
+				End(),
+		).
+		Assert(jsonpath.Root("$").Equal("order.customer.name", "jon").End()).
+		Assert(jsonpath.Chain().Equal("order.customer.name", "jon").Equal("$.order['x-id']", "o-1").End()).
+		End()
+}
+
+func TestApiTest_Root_NestedScopes_Failures(t *testing.T) {
+	body := `{"order": {"customer": {"name": "jon"}, "lines": {"sku": "a-1"}}}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.Root("$.order").Object("customer", func(c *jsonpath.AssertionChain) {
+			c.IsNumber("name")
+		}).End(), `"$.order.customer.name" has type string, expected number`},
+		{jsonpath.Root("$.order").Array("lines", func(c *jsonpath.AssertionChain) {
+			c.Equal("[0].sku", "a-1")
+		}).End(), `"$.order.lines" has type object, expected array`},
+		{jsonpath.Root("$.order").Soft().Object("customer", func(c *jsonpath.AssertionChain) {
+			c.Equal("['name']", "sue")
+		}).End(), `1 of 2 assertions failed:
+  "$.order.customer["name"]" not equal to expected value
+    expected: "sue"
+    actual:   "jon"
+    diff:
+      changed $.order.customer["name"]: "sue" -> "jon"`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
+
+func TestApiTest_Chain_Parity(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Authorization", jwt)
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(snapshotBody))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(
+			jsonpath.Root("$").
+				Len("user.roles", 2).
+				GreaterThan("user.roles", 1).
+				LessThan("user.roles", 3).
+				MatchesSnapshot("user", "user",
+					jsonpath.Redact(`$.id`, "<id>"),
+					jsonpath.Redact(`$..createdAt`, "<timestamp>"),
+					jsonpath.Redact(`$.tags[*].id`, "<id>")).
+				JWTPayloadEqual(fromAuthHeader, `$.name`, "John Doe").
+				JWTHeaderEqual(fromAuthHeader, `$.alg`, "HS256").
+				Satisfies("user.name", func(value interface{}) error {
+					if value != "jon" {
+						return fmt.Errorf("unexpected name %v", value)
+					}
+					return nil
+				}).
+				Assert(jsonpath.Not(jsonpath.Equal(`$.user.name`, "sue"))).
+				End(),
+		).
+		End()
+}
+
+func TestApiTest_Chain_Soft(t *testing.T) {
+	chain := jsonpath.Root("$.user").
+		Soft().
+		Equal("name", "sue").
+		IsNumber("age").
+		Present("email").
+		Len("roles", 2).
+		Assert(jsonpath.Present(`$.missing`)).
+		End()
+
+	err := chain(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewBuffer([]byte(`{"user": {"name": "jon", "age": "30", "email": "jon@example.com", "roles": ["admin"]}}`))),
+	}, nil)
+
+	assert.EqualError(t, err, `4 of 5 assertions failed:
+  "$.user.name" not equal to expected value
+    expected: "sue"
+    actual:   "jon"
+    diff:
+      changed $.user.name: "sue" -> "jon"
+  "$.user.age" has type string, expected number
+  $.user.roles: "1" not equal to "2"
+  value not present for expression: '$.missing'`)
+}
+
+func TestApiTest_Satisfies_Failure(t *testing.T) {
+	assertion := jsonpath.Satisfies(`$.age`, func(value interface{}) error {
+		if age, ok := value.(float64); !ok || age < 18 {
+			return errors.New("must be an adult")
+		}
+		return nil
+	})
+
+	err := assertion(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewBuffer([]byte(`{"age": 12}`))),
+	}, nil)
+
+	assert.EqualError(t, err, `"$.age" does not satisfy predicate: must be an adult`)
+}
+
 func TestApiTest_Matches_FailCompile(t *testing.T) {
 	willFailToCompile := jsonpath.Matches(`$.b[? @.key=="c"].value`, `\`)
 	err := willFailToCompile(nil, nil)
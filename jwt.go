@@ -48,7 +48,7 @@ func jwtEqual(tokenSelector func(*http.Response) (string, error), expression str
 		}
 
 		if !jsonpath.ObjectsAreEqual(value, expected) {
-			return errors.New(fmt.Sprintf("\"%s\" not equal to \"%s\"", value, expected))
+			return jsonpath.NotEqualError(expression, expected, value)
 		}
 
 		return nil
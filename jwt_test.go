@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
 
 	jsonpath "github.com/steinfletcher/apitest-jsonpath"
 )
@@ -26,6 +27,7 @@ func TestApiTest_JWT(t *testing.T) {
 		Assert(jsonpath.JWTPayloadEqual(fromAuthHeader, `$.name`, "John Doe")).
 		Assert(jsonpath.JWTPayloadEqual(fromAuthHeader, `$.sub`, "1234567890")).
 		Assert(jsonpath.JWTPayloadEqual(fromAuthHeader, `$.iat`, float64(1516239022))).
+		Assert(jsonpath.JWTPayloadEqual(fromAuthHeader, `$.iat`, 1516239022)).
 		Assert(jsonpath.JWTHeaderEqual(fromAuthHeader, `$.alg`, "HS256")).
 		Assert(jsonpath.JWTHeaderEqual(fromAuthHeader, `$.typ`, "JWT")).
 		End()
@@ -34,3 +36,15 @@ func TestApiTest_JWT(t *testing.T) {
 func fromAuthHeader(response *http.Response) (string, error) {
 	return response.Header.Get("Authorization"), nil
 }
+
+func TestApiTest_JWT_NotEqual(t *testing.T) {
+	res := &http.Response{Header: http.Header{"Authorization": []string{jwt}}}
+
+	err := jsonpath.JWTPayloadEqual(fromAuthHeader, `$.iat`, 1)(res, nil)
+
+	assert.EqualError(t, err, `"$.iat" not equal to expected value
+  expected: 1
+  actual:   1516239022
+  diff:
+    changed $.iat: 1 -> 1516239022`)
+}
@@ -2,13 +2,15 @@ package mocks
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/steinfletcher/apitest"
 	httputil "github.com/steinfletcher/apitest-jsonpath/http"
 	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
 )
 
-// Contains is a convenience function to assert that a jsonpath expression extracts a value in an array
+// Contains is a convenience function to assert that a jsonpath expression extracts a value in an array. When the
+// expression extracts a string it asserts that the string contains expected, use ContainsString to make this explicit
 func Contains(expression string, expected interface{}) apitest.Matcher {
 	return func(req *http.Request, mockReq *apitest.MockRequest) error {
 		return jsonpath.Contains(expression, expected, httputil.CopyRequest(req).Body)
@@ -42,3 +44,459 @@ func GreaterThan(expression string, minimumLength int) apitest.Matcher {
 		return jsonpath.GreaterThan(expression, minimumLength, httputil.CopyRequest(req).Body)
 	}
 }
+
+// NumberGreaterThan asserts that the numeric value is greater than the given bound
+func NumberGreaterThan(expression string, bound float64) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.NumberGreaterThan(expression, bound, httputil.CopyRequest(req).Body)
+	}
+}
+
+// NumberLessThan asserts that the numeric value is less than the given bound
+func NumberLessThan(expression string, bound float64) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.NumberLessThan(expression, bound, httputil.CopyRequest(req).Body)
+	}
+}
+
+// NumberAtLeast asserts that the numeric value is greater than or equal to the given bound
+func NumberAtLeast(expression string, bound float64) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.NumberAtLeast(expression, bound, httputil.CopyRequest(req).Body)
+	}
+}
+
+// NumberAtMost asserts that the numeric value is less than or equal to the given bound
+func NumberAtMost(expression string, bound float64) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.NumberAtMost(expression, bound, httputil.CopyRequest(req).Body)
+	}
+}
+
+// NumberBetween asserts that the numeric value is within the given inclusive range
+func NumberBetween(expression string, min, max float64) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.NumberBetween(expression, min, max, httputil.CopyRequest(req).Body)
+	}
+}
+
+// IsString asserts that the value returned by the expression is a string
+func IsString(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.IsString(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// IsNumber asserts that the value returned by the expression is a number
+func IsNumber(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.IsNumber(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// IsInteger asserts that the value returned by the expression is a number without a fractional part
+func IsInteger(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.IsInteger(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// IsBool asserts that the value returned by the expression is a boolean
+func IsBool(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.IsBool(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// IsArray asserts that the value returned by the expression is an array
+func IsArray(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.IsArray(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// IsObject asserts that the value returned by the expression is an object
+func IsObject(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.IsObject(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// IsNull asserts that the value returned by the expression is null
+func IsNull(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.IsNull(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// Exists asserts that the expression selects a value, which may be null or a zero value such as 0, false or ""
+func Exists(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.Exists(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// NotExists asserts that the expression does not select a value, e.g. the key is missing
+func NotExists(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.NotExists(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// NotNull asserts that the expression selects a value that is not null
+func NotNull(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.NotNull(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// NotEmpty asserts that the expression selects a value that is not null, "", [] or {}. Zero values such as 0 and false are not empty
+func NotEmpty(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.NotEmpty(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// Matches asserts that the value matches the given regular expression. The pattern is compiled when the matcher is
+// created
+func Matches(expression string, regexp string) apitest.Matcher {
+	pattern, err := jsonpath.CompilePattern(regexp)
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		if err != nil {
+			return err
+		}
+		return jsonpath.Matches(expression, pattern, httputil.CopyRequest(req).Body)
+	}
+}
+
+// MatchesAll asserts that every element selected by the expression, e.g. `$.items[*].sku` or `$.skus`, matches the
+// given regular expression
+func MatchesAll(expression string, regexp string) apitest.Matcher {
+	pattern, err := jsonpath.CompilePattern(regexp)
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		if err != nil {
+			return err
+		}
+		return jsonpath.MatchesAll(expression, pattern, httputil.CopyRequest(req).Body)
+	}
+}
+
+// MatchesAny asserts that at least one element selected by the expression matches the given regular expression
+func MatchesAny(expression string, regexp string) apitest.Matcher {
+	pattern, err := jsonpath.CompilePattern(regexp)
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		if err != nil {
+			return err
+		}
+		return jsonpath.MatchesAny(expression, pattern, httputil.CopyRequest(req).Body)
+	}
+}
+
+// MatchesAndCapture asserts that the value matches the given regular expression and stores its named groups in
+// captures, which must not be nil
+func MatchesAndCapture(expression string, regexp string, captures map[string]string) apitest.Matcher {
+	pattern, err := jsonpath.CompilePattern(regexp)
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		if err != nil {
+			return err
+		}
+		return jsonpath.MatchesAndCapture(expression, pattern, captures, httputil.CopyRequest(req).Body)
+	}
+}
+
+// MatchesSchema asserts that the value returned by the expression is valid against the given JSON Schema. The schema is
+// a string or []byte containing the schema document, or a string containing the path of a local schema file
+func MatchesSchema(expression string, schema interface{}) apitest.Matcher {
+	compiled, err := jsonpath.CompileSchema(schema)
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		if err != nil {
+			return err
+		}
+		return jsonpath.MatchesSchema(expression, compiled, httputil.CopyRequest(req).Body)
+	}
+}
+
+// EqualSubset asserts that the value matches the expected object or array, ignoring keys that are not in the expected value
+func EqualSubset(expression string, expected interface{}) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.EqualSubset(expression, expected, httputil.CopyRequest(req).Body)
+	}
+}
+
+// ContainsSubset asserts that the array contains an element which matches the expected value, ignoring keys that are not in the expected value
+func ContainsSubset(expression string, expected interface{}) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.ContainsSubset(expression, expected, httputil.CopyRequest(req).Body)
+	}
+}
+
+// EqualIgnoring asserts that the value equals the expected value once the values selected by the ignore paths
+// are removed from both. Ignore paths are jsonpath expressions relative to the value, e.g. `$..createdAt`
+func EqualIgnoring(expression string, expected interface{}, ignorePaths ...string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.EqualIgnoring(expression, expected, ignorePaths, httputil.CopyRequest(req).Body)
+	}
+}
+
+// All asserts that the matcher passes for every element selected by the expression, e.g. `$.items[*]` or `$.items`.
+// The matcher is evaluated against each element, so its expressions are relative to the element, e.g. `$.price`
+func All(expression string, matcher apitest.Matcher) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.All(expression, elementMatcher(matcher, req, mockReq), httputil.CopyRequest(req).Body)
+	}
+}
+
+// Any asserts that the matcher passes for at least one element selected by the expression
+func Any(expression string, matcher apitest.Matcher) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.Any(expression, elementMatcher(matcher, req, mockReq), httputil.CopyRequest(req).Body)
+	}
+}
+
+// None asserts that the matcher fails for every element selected by the expression
+func None(expression string, matcher apitest.Matcher) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.None(expression, elementMatcher(matcher, req, mockReq), httputil.CopyRequest(req).Body)
+	}
+}
+
+func elementMatcher(matcher apitest.Matcher, req *http.Request, mockReq *apitest.MockRequest) func([]byte) error {
+	return func(element []byte) error {
+		return matcher(httputil.RequestWithBody(req, element), mockReq)
+	}
+}
+
+// SortedBy asserts that the elements selected by the expression are sorted by the key expression, which is evaluated
+// against each element. Numbers are compared by value, RFC 3339 timestamps by time and other strings lexically
+func SortedBy(expression string, keyExpression string, direction jsonpath.Direction) apitest.Matcher {
+	return SortedByKeys(expression, jsonpath.SortKey{Expression: keyExpression, Direction: direction})
+}
+
+// SortedByKeys asserts that the elements selected by the expression are sorted by the given keys. Later keys are only
+// compared when all earlier keys are equal
+func SortedByKeys(expression string, keys ...jsonpath.SortKey) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.SortedBy(expression, keys, httputil.CopyRequest(req).Body)
+	}
+}
+
+// Unique asserts that the elements selected by the expression contain no duplicates
+func Unique(expression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.Unique(expression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// UniqueBy asserts that the elements selected by the expression are unique by the key expression, which is evaluated
+// against each element
+func UniqueBy(expression string, keyExpression string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.UniqueBy(expression, keyExpression, httputil.CopyRequest(req).Body)
+	}
+}
+
+// ElementsMatch asserts that the elements selected by the expression and the expected elements are the same, ignoring
+// their order. Each element must appear the same number of times in both
+func ElementsMatch(expression string, expected interface{}) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.ElementsMatch(expression, expected, httputil.CopyRequest(req).Body)
+	}
+}
+
+// InDelta asserts that the numbers selected by the expression differ from the expected numbers by at most delta.
+// Arrays and objects are compared element by element and values that are not numbers must be equal
+func InDelta(expression string, expected interface{}, delta float64) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.InDelta(expression, expected, delta, httputil.CopyRequest(req).Body)
+	}
+}
+
+// InEpsilon asserts that the relative error between the numbers selected by the expression and the expected numbers
+// is at most epsilon. An expected value of 0 only matches 0
+func InEpsilon(expression string, expected interface{}, epsilon float64) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.InEpsilon(expression, expected, epsilon, httputil.CopyRequest(req).Body)
+	}
+}
+
+// Before asserts that the timestamp selected by the expression is before t. Timestamps are parsed using the layouts,
+// which default to RFC 3339, RFC 1123 and Unix seconds or milliseconds
+func Before(expression string, t time.Time, layouts ...string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.Before(expression, t, layouts, httputil.CopyRequest(req).Body)
+	}
+}
+
+// After asserts that the timestamp selected by the expression is after t
+func After(expression string, t time.Time, layouts ...string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.After(expression, t, layouts, httputil.CopyRequest(req).Body)
+	}
+}
+
+// WithinDuration asserts that the timestamp selected by the expression is at most delta before or after t
+func WithinDuration(expression string, t time.Time, delta time.Duration, layouts ...string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.WithinDuration(expression, t, delta, layouts, httputil.CopyRequest(req).Body)
+	}
+}
+
+// BeforeNow asserts that the timestamp selected by the expression is before the current time
+func BeforeNow(expression string, layouts ...string) apitest.Matcher {
+	return BeforeNowAt(expression, time.Now, layouts...)
+}
+
+// AfterNow asserts that the timestamp selected by the expression is after the current time
+func AfterNow(expression string, layouts ...string) apitest.Matcher {
+	return AfterNowAt(expression, time.Now, layouts...)
+}
+
+// WithinDurationOfNow asserts that the timestamp selected by the expression is at most delta before or after the
+// current time
+func WithinDurationOfNow(expression string, delta time.Duration, layouts ...string) apitest.Matcher {
+	return WithinDurationOfNowAt(expression, time.Now, delta, layouts...)
+}
+
+// BeforeNowAt asserts that the timestamp selected by the expression is before the time returned by clock when the
+// matcher runs
+func BeforeNowAt(expression string, clock func() time.Time, layouts ...string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.Before(expression, clock(), layouts, httputil.CopyRequest(req).Body)
+	}
+}
+
+// AfterNowAt asserts that the timestamp selected by the expression is after the time returned by clock when the
+// matcher runs
+func AfterNowAt(expression string, clock func() time.Time, layouts ...string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.After(expression, clock(), layouts, httputil.CopyRequest(req).Body)
+	}
+}
+
+// WithinDurationOfNowAt asserts that the timestamp selected by the expression is at most delta before or after the
+// time returned by clock when the matcher runs
+func WithinDurationOfNowAt(expression string, clock func() time.Time, delta time.Duration, layouts ...string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.WithinDuration(expression, clock(), delta, layouts, httputil.CopyRequest(req).Body)
+	}
+}
+
+// Format asserts that the string selected by the expression, or every string selected by a wildcard expression, is
+// valid for the format. Custom formats are registered with jsonpath.RegisterFormat
+func Format(expression string, format string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.Format(expression, format, httputil.CopyRequest(req).Body)
+	}
+}
+
+// HasPrefix asserts that the string selected by the expression starts with prefix
+func HasPrefix(expression string, prefix string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.HasPrefix(expression, prefix, httputil.CopyRequest(req).Body)
+	}
+}
+
+// HasSuffix asserts that the string selected by the expression ends with suffix
+func HasSuffix(expression string, suffix string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.HasSuffix(expression, suffix, httputil.CopyRequest(req).Body)
+	}
+}
+
+// ContainsString asserts that the string selected by the expression contains substring. Unlike Contains it
+// never treats the value as a list
+func ContainsString(expression string, substring string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.ContainsString(expression, substring, httputil.CopyRequest(req).Body)
+	}
+}
+
+// EqualFold asserts that the string selected by the expression is equal to expected, ignoring case
+func EqualFold(expression string, expected string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.EqualFold(expression, expected, httputil.CopyRequest(req).Body)
+	}
+}
+
+// EqualNormalized asserts that the string selected by the expression is equal to expected after both are
+// converted to Unicode Normalization Form C, so that e.g. a precomposed "é" equals "e" followed by a combining accent
+func EqualNormalized(expression string, expected string) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.EqualNormalized(expression, expected, httputil.CopyRequest(req).Body)
+	}
+}
+
+// RuneLen asserts that the string selected by the expression has the expected length in runes rather than bytes
+func RuneLen(expression string, expectedLength int) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.RuneLen(expression, expectedLength, httputil.CopyRequest(req).Body)
+	}
+}
+
+// MinRuneLen asserts that the string selected by the expression has at least minLength runes
+func MinRuneLen(expression string, minLength int) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.MinRuneLen(expression, minLength, httputil.CopyRequest(req).Body)
+	}
+}
+
+// MaxRuneLen asserts that the string selected by the expression has at most maxLength runes
+func MaxRuneLen(expression string, maxLength int) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.MaxRuneLen(expression, maxLength, httputil.CopyRequest(req).Body)
+	}
+}
+
+// Satisfies asserts that the value returned by the expression satisfies a custom predicate. The predicate receives the
+// decoded value and returns an error describing why it does not match
+func Satisfies(expression string, predicate func(value interface{}) error) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.Satisfies(expression, predicate, httputil.CopyRequest(req).Body)
+	}
+}
+
+// Not asserts that the matcher fails
+func Not(matcher apitest.Matcher) apitest.Matcher {
+	return NotNamed("", matcher)
+}
+
+// NotNamed is like Not and names the expression in the failure message
+func NotNamed(expression string, matcher apitest.Matcher) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.Not(expression, bind(matcher, req, mockReq))
+	}
+}
+
+// And asserts that every matcher passes and reports all of the failures
+func And(matchers ...apitest.Matcher) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.And(bindAll(matchers, req, mockReq))
+	}
+}
+
+// Or asserts that at least one of the matchers passes
+func Or(matchers ...apitest.Matcher) apitest.Matcher {
+	return func(req *http.Request, mockReq *apitest.MockRequest) error {
+		return jsonpath.Or(bindAll(matchers, req, mockReq))
+	}
+}
+
+// AnyOf asserts that at least one of the matchers passes. It is equivalent to Or
+func AnyOf(matchers ...apitest.Matcher) apitest.Matcher {
+	return Or(matchers...)
+}
+
+// bind runs the matcher against a copy of the request so that each matcher can read the body
+func bind(matcher apitest.Matcher, req *http.Request, mockReq *apitest.MockRequest) func() error {
+	return func() error {
+		return matcher(httputil.CopyRequest(req), mockReq)
+	}
+}
+
+func bindAll(matchers []apitest.Matcher, req *http.Request, mockReq *apitest.MockRequest) []func() error {
+	bound := make([]func() error, len(matchers))
+	for i, matcher := range matchers {
+		bound[i] = bind(matcher, req, mockReq)
+	}
+	return bound
+}
@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 
 	"github.com/steinfletcher/apitest-jsonpath/mocks"
 
@@ -18,6 +22,9 @@ func TestMocks(t *testing.T) {
 		Post("/user-api").
 		AddMatcher(mocks.Equal("$.name", "jon")).
 		AddMatcher(mocks.Equal("$.name", "jon")). // ensure body can be re read after running matcher
+		AddMatcher(mocks.Equal("$", struct {
+			Name string `json:"name"`
+		}{Name: "jon"})).
 		RespondWith().
 		Body(`{"name": "jon", "id": "1234"}`).
 		Status(http.StatusOK).
@@ -40,6 +47,77 @@ func TestMocks(t *testing.T) {
 		End()
 }
 
+func TestMocks_NumberComparisons(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/user-api", strings.NewReader(`{"age": 30}`))
+
+	assert.NoError(t, mocks.NumberBetween("$.age", 18, 65)(req, nil))
+	assert.NoError(t, mocks.NumberAtLeast("$.age", 30)(req, nil))
+	assert.EqualError(t, mocks.NumberGreaterThan("$.age", 30)(req, nil), `"30" is not greater than "30"`)
+}
+
+func TestMocks_MatchesSchema(t *testing.T) {
+	matcher := mocks.MatchesSchema("$", `{"type": "object", "required": ["name"], "properties": {"name": {"type": "string"}}}`)
+
+	valid := httptest.NewRequest(http.MethodPost, "/user-api", strings.NewReader(`{"name": "jon"}`))
+	invalid := httptest.NewRequest(http.MethodPost, "/user-api", strings.NewReader(`{"name": 1}`))
+
+	assert.NoError(t, matcher(valid, nil))
+	assert.EqualError(t, matcher(invalid, nil), `"$" does not match schema:
+  $.name: expected string, but got number`)
+}
+
+func TestMocks_Equal_Diff(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/user-api", strings.NewReader(`{"name": "jon", "age": 30}`))
+
+	err := mocks.Equal("$", map[string]interface{}{"name": "sue", "age": 30})(req, nil)
+
+	assert.EqualError(t, err, `"$" not equal to expected value
+  expected: {"age":30,"name":"sue"}
+  actual:   {"age":30,"name":"jon"}
+  diff:
+    changed $.name: "sue" -> "jon"`)
+}
+
+func TestMocks_All(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{"items": [{"sku": "a-1"}, {"sku": 2}]}`))
+
+	assert.NoError(t, mocks.Any("$.items[*]", mocks.IsString("$.sku"))(req, nil))
+	assert.EqualError(t, mocks.All("$.items[*]", mocks.IsString("$.sku"))(req, nil), `1 of 2 elements of "$.items[*]" failed:
+  $.items[1]: "$.sku" has type number, expected string`)
+}
+
+func TestMocks_AfterNow(t *testing.T) {
+	clock := func() time.Time { return time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC) }
+
+	req := httptest.NewRequest(http.MethodPost, "/user-api", strings.NewReader(`{"expiresAt": "2021-03-01T11:00:00Z"}`))
+
+	assert.NoError(t, mocks.AfterNowAt("$.expiresAt", clock)(req, nil))
+	assert.NoError(t, mocks.WithinDurationOfNowAt("$.expiresAt", clock, time.Hour)(req, nil))
+	assert.EqualError(t, mocks.BeforeNowAt("$.expiresAt", clock)(req, nil), `"$.expiresAt" 2021-03-01T11:00:00Z is not before 2021-03-01T10:00:00Z`)
+	assert.NoError(t, mocks.BeforeNow("$.expiresAt", "2006-01-02T15:04:05Z07:00")(req, nil))
+}
+
+func TestMocks_MatchesAll(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{"items": [{"sku": "sku-1"}, {"sku": "abc"}]}`))
+
+	assert.NoError(t, mocks.MatchesAny("$.items[*].sku", `^sku-\d+$`)(req, nil))
+	assert.EqualError(t, mocks.MatchesAll("$.items[*].sku", `^sku-\d+$`)(req, nil), `1 of 2 elements of "$.items[*].sku" do not match pattern '^sku-\d+$':
+  $.items[1].sku: value 'abc' does not match pattern '^sku-\d+$'`)
+}
+
+func TestMocks_Combinators(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/user-api", strings.NewReader(`{"status": "pending"}`))
+
+	assert.NoError(t, mocks.AnyOf(mocks.Equal("$.status", "active"), mocks.Equal("$.status", "pending"))(req, nil))
+	assert.NoError(t, mocks.Not(mocks.Equal("$.status", "deleted"))(req, nil))
+	assert.EqualError(t, mocks.And(mocks.IsString("$.status"), mocks.Equal("$.status", "active"))(req, nil), `1 of 2 assertions failed:
+  - "$.status" not equal to expected value
+    expected: "active"
+    actual:   "pending"
+    diff:
+      changed $.status: "active" -> "pending"`)
+}
+
 func myHandler() *http.ServeMux {
 	handler := http.NewServeMux()
 	handler.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,42 @@
+package jsonpath
+
+import (
+	"net/http"
+
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// MatchesAll asserts that every element selected by the expression, e.g. `$.items[*].sku` or `$.skus`, matches the
+// given regular expression
+func MatchesAll(expression string, regexp string) func(*http.Response, *http.Request) error {
+	pattern, err := jsonpath.CompilePattern(regexp)
+	return func(res *http.Response, req *http.Request) error {
+		if err != nil {
+			return err
+		}
+		return jsonpath.MatchesAll(expression, pattern, res.Body)
+	}
+}
+
+// MatchesAny asserts that at least one element selected by the expression matches the given regular expression
+func MatchesAny(expression string, regexp string) func(*http.Response, *http.Request) error {
+	pattern, err := jsonpath.CompilePattern(regexp)
+	return func(res *http.Response, req *http.Request) error {
+		if err != nil {
+			return err
+		}
+		return jsonpath.MatchesAny(expression, pattern, res.Body)
+	}
+}
+
+// MatchesAndCapture asserts that the value matches the given regular expression and stores its named groups in
+// captures, e.g. the pattern `^/users/(?P<id>\d+)$` stores the id under "id". The assertion fails when captures is nil
+func MatchesAndCapture(expression string, regexp string, captures map[string]string) func(*http.Response, *http.Request) error {
+	pattern, err := jsonpath.CompilePattern(regexp)
+	return func(res *http.Response, req *http.Request) error {
+		if err != nil {
+			return err
+		}
+		return jsonpath.MatchesAndCapture(expression, pattern, captures, res.Body)
+	}
+}
@@ -0,0 +1,84 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+func TestApiTest_MatchesAll_MatchesAny(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"items": [{"sku": "sku-1"}, {"sku": "sku-22"}], "codes": ["a1", "b2", "zz"], "ids": [7, 8]}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.MatchesAll(`$.items[*].sku`, `^sku-\d+$`)).
+		Assert(jsonpath.MatchesAll(`$.ids`, `^\d$`)).
+		Assert(jsonpath.MatchesAny(`$.codes`, `^[a-z]\d$`)).
+		Assert(jsonpath.Root("$").MatchesAll("items[*].sku", `^sku-`).MatchesAny("codes", `^z+$`).End()).
+		End()
+}
+
+func TestApiTest_MatchesAndCapture(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"location": "/users/42/orders/abc"}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	captures := map[string]string{}
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.MatchesAndCapture(`$.location`, `^/users/(?P<user>\d+)/orders/(?P<order>\w+)$`, captures)).
+		End()
+
+	assert.Equal(t, map[string]string{"user": "42", "order": "abc"}, captures)
+}
+
+func TestApiTest_MatchesAll_MatchesAny_Failures(t *testing.T) {
+	body := `{"items": [{"sku": "sku-1"}, {"sku": "abc"}, {"sku": {"id": 1}}], "codes": ["a", "b"], "empty": [], "location": "/orders"}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.MatchesAll(`$.items[*].sku`, `^sku-\d+$`), `2 of 3 elements of "$.items[*].sku" do not match pattern '^sku-\d+$':
+  $.items[1].sku: value 'abc' does not match pattern '^sku-\d+$'
+  $.items[2].sku: unable to match using type: map`},
+		{jsonpath.MatchesAny(`$.codes`, `^\d$`), `no elements of "$.codes" match pattern '^\d$':
+  $.codes[0]: value 'a' does not match pattern '^\d$'
+  $.codes[1]: value 'b' does not match pattern '^\d$'`},
+		{jsonpath.MatchesAny(`$.empty`, `.+`), `no elements of "$.empty" match pattern '.+': "$.empty" is empty`},
+		{jsonpath.MatchesAll(`$.codes`, `\`), `invalid pattern: '\'`},
+		{jsonpath.MatchesAndCapture(`$.location`, `^/users/(?P<id>\d+)$`, map[string]string{}), `value '/orders' does not match pattern '^/users/(?P<id>\d+)$'`},
+		{jsonpath.MatchesAndCapture(`$.location`, `^/users/(?P<id>\d+)$`, nil), `captures map for "$.location" is nil`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
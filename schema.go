@@ -0,0 +1,20 @@
+package jsonpath
+
+import (
+	"net/http"
+
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// MatchesSchema asserts that the value returned by the expression is valid against the given JSON Schema. The schema is
+// a string or []byte containing the schema document, or a string containing the path of a local schema file.
+// Use the expression `$` to validate the whole body
+func MatchesSchema(expression string, schema interface{}) func(*http.Response, *http.Request) error {
+	compiled, err := jsonpath.CompileSchema(schema)
+	return func(res *http.Response, req *http.Request) error {
+		if err != nil {
+			return err
+		}
+		return jsonpath.MatchesSchema(expression, compiled, res.Body)
+	}
+}
@@ -0,0 +1,84 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+const itemsSchema = `{
+	"type": "array",
+	"items": {
+		"type": "object",
+		"required": ["sku", "price"],
+		"properties": {
+			"sku": {"type": "string"},
+			"price": {"type": "number", "exclusiveMinimum": 0}
+		}
+	}
+}`
+
+func TestApiTest_MatchesSchema(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"user": {"id": "1234", "name": "jon", "age": 30}, "items": [{"sku": "a-1", "price": 1.5}]}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.MatchesSchema(`$.items`, itemsSchema)).
+		Assert(jsonpath.MatchesSchema(`$.items`, []byte(itemsSchema))).
+		Assert(jsonpath.MatchesSchema(`$.user`, "testdata/user.schema.json")).
+		Assert(jsonpath.MatchesSchema(`$`, `{"type": "object", "required": ["user", "items"]}`)).
+		Assert(jsonpath.Root("$").MatchesSchema("user", "testdata/user.schema.json").End()).
+		End()
+}
+
+func TestApiTest_MatchesSchema_ListsViolations(t *testing.T) {
+	matcher := jsonpath.MatchesSchema(`$.items`, itemsSchema)
+
+	err := matcher(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewBuffer([]byte(`{"items": [{"sku": "a-1", "price": 1}, {"sku": 2, "price": 0}, {"price": 3}]}`))),
+	}, nil)
+
+	assert.EqualError(t, err, `"$.items" does not match schema:
+  $.items[1].price: must be > 0 but found 0
+  $.items[1].sku: expected string, but got number
+  $.items[2]: missing properties: 'sku'`)
+}
+
+func TestApiTest_MatchesSchema_FromFile(t *testing.T) {
+	matcher := jsonpath.MatchesSchema(`$`, "testdata/user.schema.json")
+
+	err := matcher(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewBuffer([]byte(`{"id": "1", "age": -1}`))),
+	}, nil)
+
+	assert.EqualError(t, err, `"$" does not match schema:
+  $: missing properties: 'name'
+  $.age: must be >= 0 but found -1`)
+}
+
+func TestApiTest_MatchesSchema_InvalidSchema(t *testing.T) {
+	matcher := jsonpath.MatchesSchema(`$`, `{"type": 12}`)
+
+	err := matcher(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewBuffer([]byte(`{}`))),
+	}, nil)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid schema")
+}
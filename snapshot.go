@@ -0,0 +1,26 @@
+package jsonpath
+
+import (
+	"net/http"
+	"path"
+
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// Redaction replaces the values selected by a jsonpath expression with a placeholder before a snapshot is compared
+type Redaction = jsonpath.Redaction
+
+// Redact creates a Redaction replacing the values selected by the expression, relative to the snapshot value, with
+// the placeholder
+func Redact(expression string, placeholder interface{}) Redaction {
+	return Redaction{Expression: expression, Placeholder: placeholder}
+}
+
+// MatchesSnapshot asserts that the value returned by the expression matches the golden file testdata/snapshots/<name>.json.
+// Run the tests with UPDATE_SNAPSHOTS=true to create or update the file
+func MatchesSnapshot(expression string, name string, redactions ...Redaction) func(*http.Response, *http.Request) error {
+	file := path.Join("testdata", "snapshots", name+".json")
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.MatchesSnapshot(expression, file, redactions, res.Body)
+	}
+}
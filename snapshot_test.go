@@ -0,0 +1,92 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+const snapshotBody = `{"user": {"name": "jon", "id": "8f14e45f", "createdAt": "2021-03-01T10:00:00Z", "roles": ["admin", "user"], "tags": [{"id": "1", "key": "a"}]}}`
+
+func TestApiTest_MatchesSnapshot(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(snapshotBody))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.MatchesSnapshot(`$.user`, "user",
+			jsonpath.Redact(`$.id`, "<id>"),
+			jsonpath.Redact(`$..createdAt`, "<timestamp>"),
+			jsonpath.Redact(`$.tags[*].id`, "<id>"))).
+		End()
+}
+
+func TestApiTest_MatchesSnapshot_Mismatch(t *testing.T) {
+	defer disableSnapshotUpdates()()
+	matcher := jsonpath.MatchesSnapshot(`$.user`, "user_mismatch")
+
+	err := matcher(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewBuffer([]byte(snapshotBody))),
+	}, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"$.user" does not match snapshot 'testdata/snapshots/user_mismatch.json'`)
+}
+
+func TestApiTest_MatchesSnapshot_Missing(t *testing.T) {
+	defer disableSnapshotUpdates()()
+	matcher := jsonpath.MatchesSnapshot(`$.user`, "missing")
+
+	err := matcher(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewBuffer([]byte(snapshotBody))),
+	}, nil)
+
+	assert.EqualError(t, err, "snapshot 'testdata/snapshots/missing.json' does not exist, run the tests with UPDATE_SNAPSHOTS=true to create it")
+}
+
+func TestApiTest_MatchesSnapshot_Update(t *testing.T) {
+	file := filepath.Join("testdata", "snapshots", "update.json")
+	defer os.Remove(file)
+	defer disableSnapshotUpdates()()
+
+	os.Setenv("UPDATE_SNAPSHOTS", "true")
+	err := jsonpath.MatchesSnapshot(`$.user`, "update", jsonpath.Redact(`$.tags`, nil))(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewBuffer([]byte(`{"user": {"name": "jon", "id": 1, "tags": ["<b>"]}}`))),
+	}, nil)
+	os.Unsetenv("UPDATE_SNAPSHOTS")
+	assert.NoError(t, err)
+
+	b, err := ioutil.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"id\": 1,\n  \"name\": \"jon\",\n  \"tags\": null\n}\n", string(b))
+}
+
+// disableSnapshotUpdates unsets UPDATE_SNAPSHOTS so that the snapshot fixtures are compared rather than rewritten.
+// The returned func restores the previous value
+func disableSnapshotUpdates() func() {
+	value, ok := os.LookupEnv("UPDATE_SNAPSHOTS")
+	os.Unsetenv("UPDATE_SNAPSHOTS")
+	return func() {
+		if ok {
+			os.Setenv("UPDATE_SNAPSHOTS", value)
+		}
+	}
+}
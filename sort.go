@@ -0,0 +1,34 @@
+package jsonpath
+
+import (
+	"net/http"
+
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// Direction is the direction of a sort order
+type Direction = jsonpath.Direction
+
+// SortKey describes one key of a sort order. Its expression is evaluated against each element, e.g. `$.createdAt`
+type SortKey = jsonpath.SortKey
+
+const (
+	// Ascending sorts from the smallest value to the largest
+	Ascending = jsonpath.Ascending
+	// Descending sorts from the largest value to the smallest
+	Descending = jsonpath.Descending
+)
+
+// SortedBy asserts that the elements selected by the expression are sorted by the key expression, which is evaluated
+// against each element. Numbers are compared by value, RFC 3339 timestamps by time and other strings lexically
+func SortedBy(expression string, keyExpression string, direction Direction) func(*http.Response, *http.Request) error {
+	return SortedByKeys(expression, SortKey{Expression: keyExpression, Direction: direction})
+}
+
+// SortedByKeys asserts that the elements selected by the expression are sorted by the given keys. Later keys are only
+// compared when all earlier keys are equal
+func SortedByKeys(expression string, keys ...SortKey) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.SortedBy(expression, keys, res.Body)
+	}
+}
@@ -0,0 +1,69 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+func TestApiTest_SortedBy(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{
+			"items": [
+				{"name": "apple", "lastName": "Doe", "price": 2, "createdAt": "2021-03-01T10:00:00Z"},
+				{"name": "Banana", "lastName": "Doe", "price": 10, "createdAt": "2021-03-01T09:00:00+02:00"},
+				{"name": "cherry", "lastName": "Smith", "price": 10, "createdAt": "2021-02-28T10:00:00Z"}
+			],
+			"scores": [1, 5, 5, 9]
+		}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.SortedBy(`$.items`, `$.price`, jsonpath.Ascending)).
+		Assert(jsonpath.SortedBy(`$.items[*]`, `$.createdAt`, jsonpath.Descending)).
+		Assert(jsonpath.SortedBy(`$.scores`, `$`, jsonpath.Ascending)).
+		Assert(jsonpath.SortedByKeys(`$.items`, jsonpath.SortKey{Expression: `$.name`, IgnoreCase: true})).
+		Assert(jsonpath.SortedByKeys(`$.items`,
+			jsonpath.SortKey{Expression: `$.lastName`, Direction: jsonpath.Ascending},
+			jsonpath.SortKey{Expression: `$.price`, Direction: jsonpath.Ascending})).
+		Assert(jsonpath.Root("$").SortedBy("items", "$.price", jsonpath.Ascending).End()).
+		End()
+}
+
+func TestApiTest_SortedBy_Failures(t *testing.T) {
+	body := `{"items": [{"name": "b", "price": 1}, {"name": "a", "price": 3}, {"name": "c", "price": 2}, {"name": "d", "price": "2"}], "dates": ["2021-01-01T00:00:00Z", "2021-02-01T00:00:00Z"]}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.SortedBy(`$.items[0:3]`, `$.price`, jsonpath.Ascending), `"$.items[0:3]" is not sorted by $.price ascending: $.items[1] (3) should not come before $.items[2] (2)`},
+		{jsonpath.SortedBy(`$.items`, `$.name`, jsonpath.Ascending), `"$.items" is not sorted by $.name ascending: $.items[0] ("b") should not come before $.items[1] ("a")`},
+		{jsonpath.SortedBy(`$.dates`, `$`, jsonpath.Descending), `"$.dates" is not sorted by $ descending: $.dates[0] ("2021-01-01T00:00:00Z") should not come before $.dates[1] ("2021-02-01T00:00:00Z")`},
+		{jsonpath.SortedByKeys(`$.items`, jsonpath.SortKey{Expression: `$.price`, Direction: jsonpath.Descending}, jsonpath.SortKey{Expression: `$.name`, IgnoreCase: true}), `"$.items" is not sorted by $.price descending, $.name ascending ignoring case: $.items[0] ([1,"b"]) should not come before $.items[1] ([3,"a"])`},
+		{jsonpath.SortedBy(`$.items[2:4]`, `$.price`, jsonpath.Ascending), `cannot compare $.items[2] and $.items[3] by $.price: 2 has type number, "2" has type string`},
+		{jsonpath.SortedBy(`$.items`, `$.id`, jsonpath.Ascending), `$.items[0]: evaluating '$.id' resulted in error: 'unknown key id'`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
@@ -0,0 +1,65 @@
+package jsonpath
+
+import (
+	"net/http"
+
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// HasPrefix asserts that the string selected by the expression starts with prefix
+func HasPrefix(expression string, prefix string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.HasPrefix(expression, prefix, res.Body)
+	}
+}
+
+// HasSuffix asserts that the string selected by the expression ends with suffix
+func HasSuffix(expression string, suffix string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.HasSuffix(expression, suffix, res.Body)
+	}
+}
+
+// ContainsString asserts that the string selected by the expression contains substring. Unlike Contains it
+// never treats the value as a list
+func ContainsString(expression string, substring string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.ContainsString(expression, substring, res.Body)
+	}
+}
+
+// EqualFold asserts that the string selected by the expression is equal to expected, ignoring case
+func EqualFold(expression string, expected string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.EqualFold(expression, expected, res.Body)
+	}
+}
+
+// EqualNormalized asserts that the string selected by the expression is equal to expected after both are
+// converted to Unicode Normalization Form C, so that e.g. a precomposed "é" equals "e" followed by a combining accent
+func EqualNormalized(expression string, expected string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.EqualNormalized(expression, expected, res.Body)
+	}
+}
+
+// RuneLen asserts that the string selected by the expression has the expected length in runes rather than bytes
+func RuneLen(expression string, expectedLength int) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.RuneLen(expression, expectedLength, res.Body)
+	}
+}
+
+// MinRuneLen asserts that the string selected by the expression has at least minLength runes
+func MinRuneLen(expression string, minLength int) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.MinRuneLen(expression, minLength, res.Body)
+	}
+}
+
+// MaxRuneLen asserts that the string selected by the expression has at most maxLength runes
+func MaxRuneLen(expression string, maxLength int) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.MaxRuneLen(expression, maxLength, res.Body)
+	}
+}
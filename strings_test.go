@@ -0,0 +1,67 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+func TestApiTest_Strings(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"url": "https://example.com/users", "name": "Zoe", "city": "Z\u00fcrich", "decomposed": "Zu\u0308rich"}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.HasPrefix(`$.url`, "https://")).
+		Assert(jsonpath.HasSuffix(`$.url`, "/users")).
+		Assert(jsonpath.ContainsString(`$.url`, "example.com")).
+		Assert(jsonpath.EqualFold(`$.name`, "zOE")).
+		Assert(jsonpath.EqualNormalized(`$.decomposed`, "Z\u00fcrich")).
+		Assert(jsonpath.RuneLen(`$.city`, 6)).
+		Assert(jsonpath.MinRuneLen(`$.decomposed`, 7)).
+		Assert(jsonpath.MaxRuneLen(`$.city`, 6)).
+		Assert(jsonpath.Root("$").HasPrefix("url", "https://").RuneLen("name", 3).End()).
+		End()
+}
+
+func TestApiTest_Strings_Failures(t *testing.T) {
+	body := `{"url": "http://example.com", "name": "Zoe", "city": "Z\u00fcrich", "decomposed": "Zu\u0308rich", "age": 30, "tags": ["a"]}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.HasPrefix(`$.url`, "https://"), `"$.url" value "http://example.com" does not start with "https://"`},
+		{jsonpath.HasSuffix(`$.url`, "/users"), `"$.url" value "http://example.com" does not end with "/users"`},
+		{jsonpath.ContainsString(`$.url`, "example.org"), `"$.url" value "http://example.com" does not contain "example.org"`},
+		{jsonpath.EqualFold(`$.name`, "Zoey"), `"$.name" value "Zoe" is not equal to "Zoey" ignoring case`},
+		{jsonpath.EqualNormalized(`$.decomposed`, "Zurich"), "\"$.decomposed\" value \"Zu\u0308rich\" is not equal to \"Zurich\" after Unicode normalization"},
+		{jsonpath.RuneLen(`$.city`, 7), `"$.city" has 6 runes, expected 7`},
+		{jsonpath.MinRuneLen(`$.name`, 4), `"$.name" has 3 runes, expected at least 4`},
+		{jsonpath.MaxRuneLen(`$.decomposed`, 6), `"$.decomposed" has 7 runes, expected at most 6`},
+		{jsonpath.HasPrefix(`$.age`, "3"), `"$.age" has type number, expected string`},
+		{jsonpath.ContainsString(`$.tags`, "a"), `"$.tags" has type array, expected string`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
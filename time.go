@@ -0,0 +1,77 @@
+package jsonpath
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+const (
+	// UnixSeconds is a layout for timestamps given as the number of seconds since the Unix epoch
+	UnixSeconds = jsonpath.UnixSeconds
+	// UnixMillis is a layout for timestamps given as the number of milliseconds since the Unix epoch
+	UnixMillis = jsonpath.UnixMillis
+)
+
+// Before asserts that the timestamp selected by the expression is before t. Timestamps are parsed using the layouts,
+// which default to RFC 3339, RFC 1123 and Unix seconds or milliseconds
+func Before(expression string, t time.Time, layouts ...string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.Before(expression, t, layouts, res.Body)
+	}
+}
+
+// After asserts that the timestamp selected by the expression is after t
+func After(expression string, t time.Time, layouts ...string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.After(expression, t, layouts, res.Body)
+	}
+}
+
+// WithinDuration asserts that the timestamp selected by the expression is at most delta before or after t
+func WithinDuration(expression string, t time.Time, delta time.Duration, layouts ...string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.WithinDuration(expression, t, delta, layouts, res.Body)
+	}
+}
+
+// BeforeNow asserts that the timestamp selected by the expression is before the current time
+func BeforeNow(expression string, layouts ...string) func(*http.Response, *http.Request) error {
+	return BeforeNowAt(expression, time.Now, layouts...)
+}
+
+// AfterNow asserts that the timestamp selected by the expression is after the current time
+func AfterNow(expression string, layouts ...string) func(*http.Response, *http.Request) error {
+	return AfterNowAt(expression, time.Now, layouts...)
+}
+
+// WithinDurationOfNow asserts that the timestamp selected by the expression is at most delta before or after the
+// current time
+func WithinDurationOfNow(expression string, delta time.Duration, layouts ...string) func(*http.Response, *http.Request) error {
+	return WithinDurationOfNowAt(expression, time.Now, delta, layouts...)
+}
+
+// BeforeNowAt asserts that the timestamp selected by the expression is before the time returned by clock when the
+// assertion runs. Pass a fixed clock to make tests deterministic
+func BeforeNowAt(expression string, clock func() time.Time, layouts ...string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.Before(expression, clock(), layouts, res.Body)
+	}
+}
+
+// AfterNowAt asserts that the timestamp selected by the expression is after the time returned by clock when the
+// assertion runs
+func AfterNowAt(expression string, clock func() time.Time, layouts ...string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.After(expression, clock(), layouts, res.Body)
+	}
+}
+
+// WithinDurationOfNowAt asserts that the timestamp selected by the expression is at most delta before or after the
+// time returned by clock when the assertion runs
+func WithinDurationOfNowAt(expression string, clock func() time.Time, delta time.Duration, layouts ...string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.WithinDuration(expression, clock(), delta, layouts, res.Body)
+	}
+}
@@ -0,0 +1,76 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+func TestApiTest_Time(t *testing.T) {
+	now := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{
+			"createdAt": "2021-03-01T09:59:58.5Z",
+			"expiresAt": "Mon, 01 Mar 2021 12:00:00 +0200",
+			"updatedAt": 1614592800,
+			"deletedAt": 1614592801500,
+			"birthday": "1990-05-17"
+		}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.BeforeNowAt(`$.createdAt`, clock)).
+		Assert(jsonpath.WithinDurationOfNowAt(`$.createdAt`, clock, 2*time.Second)).
+		Assert(jsonpath.WithinDurationOfNowAt(`$.expiresAt`, clock, 0)).
+		Assert(jsonpath.WithinDurationOfNowAt(`$.updatedAt`, clock, 0)).
+		Assert(jsonpath.WithinDurationOfNowAt(`$.updatedAt`, clock, 0, jsonpath.UnixSeconds)).
+		Assert(jsonpath.AfterNowAt(`$.deletedAt`, clock)).
+		Assert(jsonpath.WithinDuration(`$.deletedAt`, now.Add(1500*time.Millisecond), 0, jsonpath.UnixMillis)).
+		Assert(jsonpath.BeforeNow(`$.birthday`, "2006-01-02")).
+		Assert(jsonpath.Before(`$.birthday`, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "2006-01-02")).
+		Assert(jsonpath.After(`$.birthday`, time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), "2006-01-02")).
+		Assert(jsonpath.Root("$").BeforeNowAt("createdAt", clock).AfterNowAt("deletedAt", clock).End()).
+		End()
+}
+
+func TestApiTest_Time_Failures(t *testing.T) {
+	reference := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	body := `{"createdAt": "2021-03-01T10:00:00Z", "updatedAt": 1614592810, "birthday": "1990-02-30", "name": "jon"}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.Before(`$.createdAt`, reference), `"$.createdAt" 2021-03-01T10:00:00Z is not before 2021-03-01T10:00:00Z`},
+		{jsonpath.After(`$.createdAt`, reference.Add(time.Minute)), `"$.createdAt" 2021-03-01T10:00:00Z is not after 2021-03-01T10:01:00Z`},
+		{jsonpath.WithinDuration(`$.updatedAt`, reference, 5*time.Second), `"$.updatedAt" 2021-03-01T10:00:10Z is not within 5s of 2021-03-01T10:00:00Z, difference 10s`},
+		{jsonpath.Before(`$.birthday`, reference, "2006-01-02"), `"1990-02-30" is not a timestamp matching "2006-01-02"`},
+		{jsonpath.Before(`$.name`, reference), `"jon" is not a timestamp matching RFC 3339, RFC 1123`},
+		{jsonpath.Before(`$.createdAt`, reference, jsonpath.UnixSeconds), `"2021-03-01T10:00:00Z" is not a timestamp matching Unix seconds`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
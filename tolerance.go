@@ -0,0 +1,23 @@
+package jsonpath
+
+import (
+	"net/http"
+
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// InDelta asserts that the numbers selected by the expression differ from the expected numbers by at most delta.
+// Arrays and objects are compared element by element and values that are not numbers must be equal
+func InDelta(expression string, expected interface{}, delta float64) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.InDelta(expression, expected, delta, res.Body)
+	}
+}
+
+// InEpsilon asserts that the relative error between the numbers selected by the expression and the expected numbers
+// is at most epsilon. An expected value of 0 only matches 0
+func InEpsilon(expression string, expected interface{}, epsilon float64) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.InEpsilon(expression, expected, epsilon, res.Body)
+	}
+}
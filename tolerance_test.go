@@ -0,0 +1,86 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+func TestApiTest_InDelta_InEpsilon(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{
+			"average": 3.3333333333333335,
+			"rates": [0.1, 0.2, 0.30000000000000004],
+			"price": {"amount": 10.004, "currency": "EUR"},
+			"items": [{"price": 2.5}, {"price": 0.30000000000000004}],
+			"zero": 0
+		}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.InDelta(`$.average`, 3.33, 0.01)).
+		Assert(jsonpath.InDelta(`$.rates`, []float64{0.1, 0.2, 0.3}, 1e-9)).
+		Assert(jsonpath.InDelta(`$.price`, map[string]interface{}{"amount": 10, "currency": "EUR"}, 0.005)).
+		Assert(jsonpath.InEpsilon(`$.average`, 3.3, 0.02)).
+		Assert(jsonpath.InEpsilon(`$.zero`, 0, 0.1)).
+		Assert(jsonpath.InDelta(`$.items[*].price`, []float64{2.5, 0.3}, 1e-9)).
+		Assert(jsonpath.Root("$").InDelta("price.amount", 10, 0.01).InEpsilon("rates", []float64{0.1, 0.2, 0.3}, 0.001).End()).
+		End()
+}
+
+func TestApiTest_InDelta_InEpsilon_Failures(t *testing.T) {
+	body := `{"average": 3.5, "rates": [0.1, 0.25], "price": {"amount": 10.5, "currency": "EUR"}, "items": [{"price": 2.5}, {"price": 1}], "zero": 0.1, "name": "jon"}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.InDelta(`$.average`, 3.33, 0.1), `"$.average" is not within delta 0.1 of expected value:
+  $.average: expected 3.33 but was 3.5, difference 0.17`},
+		{jsonpath.InDelta(`$.rates`, []float64{0.1, 0.2}, 0.01), `"$.rates" is not within delta 0.01 of expected value:
+  $.rates[1]: expected 0.2 but was 0.25, difference 0.05`},
+		{jsonpath.InDelta(`$.rates`, []float64{0.1}, 0.01), `"$.rates" is not within delta 0.01 of expected value:
+  $.rates: expected 1 elements but was 2`},
+		{jsonpath.InDelta(`$.price`, map[string]interface{}{"amount": 10, "currency": "USD", "tax": 1}, 0.1), `"$.price" is not within delta 0.1 of expected value:
+  $.price.amount: expected 10 but was 10.5, difference 0.5
+  $.price.currency: expected "USD" but was "EUR"
+  $.price.tax: missing`},
+		{jsonpath.InDelta(`$.price`, map[string]interface{}{"amount": 10.5}, 0.1), `"$.price" is not within delta 0.1 of expected value:
+  $.price.currency: not expected`},
+		{jsonpath.InDelta(`$.name`, 1, 0.1), `"$.name" is not within delta 0.1 of expected value:
+  $.name: expected number but was string`},
+		{jsonpath.InDelta(`$.items[*].price`, []float64{2.4, 1}, 0.01), `"$.items[*].price" is not within delta 0.01 of expected value:
+  $.items[0].price: expected 2.4 but was 2.5, difference 0.1`},
+		{jsonpath.InDelta(`$.items[*].price`, []float64{2.5}, 0.01), `"$.items[*].price" is not within delta 0.01 of expected value:
+  $.items[*].price: expected 1 elements but was 2`},
+		{jsonpath.InDelta(`$..price`, 2.5, 0.01), `"$..price" is not within delta 0.01 of expected value:
+  $..price: expected number but was array`},
+		{jsonpath.InDelta(`$.average`, 3.5, -1), `delta must be a non-negative number but was -1`},
+		{jsonpath.InEpsilon(`$.average`, 3, 0.1), `"$.average" is not within epsilon 0.1 of expected value:
+  $.average: expected 3 but was 3.5, relative error 0.166667`},
+		{jsonpath.InEpsilon(`$.zero`, 0, 0.5), `"$.zero" is not within epsilon 0.5 of expected value:
+  $.zero: expected 0 but was 0.1, relative error is undefined for an expected value of 0`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}
@@ -0,0 +1,22 @@
+package jsonpath
+
+import (
+	"net/http"
+
+	"github.com/steinfletcher/apitest-jsonpath/jsonpath"
+)
+
+// Unique asserts that the elements selected by the expression contain no duplicates
+func Unique(expression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.Unique(expression, res.Body)
+	}
+}
+
+// UniqueBy asserts that the elements selected by the expression are unique by the key expression, which is evaluated
+// against each element
+func UniqueBy(expression string, keyExpression string) func(*http.Response, *http.Request) error {
+	return func(res *http.Response, req *http.Request) error {
+		return jsonpath.UniqueBy(expression, keyExpression, res.Body)
+	}
+}
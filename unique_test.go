@@ -0,0 +1,65 @@
+package jsonpath_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+
+	jsonpath "github.com/steinfletcher/apitest-jsonpath"
+)
+
+func TestApiTest_Unique(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{
+			"items": [{"id": 1, "tags": ["a"]}, {"id": 2, "tags": ["a", "b"]}, {"id": 3, "tags": []}],
+			"users": [{"email": "a@example.com", "name": "jon"}, {"email": "b@example.com", "name": "jon"}]
+		}`))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	apitest.New().
+		Handler(handler).
+		Get("/hello").
+		Expect(t).
+		Assert(jsonpath.Unique(`$.items[*].id`)).
+		Assert(jsonpath.Unique(`$.items`)).
+		Assert(jsonpath.Unique(`$.items[*].tags`)).
+		Assert(jsonpath.UniqueBy(`$.users`, `$.email`)).
+		Assert(jsonpath.Root("$").UniqueBy("users", "$.email").Unique("items[*].id").End()).
+		End()
+}
+
+func TestApiTest_Unique_Failures(t *testing.T) {
+	body := `{"ids": [1, 2.0, 1, 3, 2, 1], "users": [{"id": 1, "role": {"name": "admin"}}, {"id": 2, "role": {"name": "user"}}, {"id": 3, "role": {"name": "admin"}}], "id": 5}`
+	testCases := []struct {
+		assertion func(*http.Response, *http.Request) error
+		expected  string
+	}{
+		{jsonpath.Unique(`$.ids`), `"$.ids" contains duplicate values:
+  1 at indices 0, 2, 5
+  2 at indices 1, 4`},
+		{jsonpath.Unique(`$.users[*].role`), `"$.users[*].role" contains duplicate values:
+  {"name":"admin"} at indices 0, 2`},
+		{jsonpath.UniqueBy(`$.users`, `$.role.name`), `"$.users" contains duplicate values of $.role.name:
+  "admin" at indices 0, 2`},
+		{jsonpath.UniqueBy(`$.users`, `$.email`), `$.users[0]: evaluating '$.email' resulted in error: 'unknown key email'`},
+		{jsonpath.Unique(`$.id`), `"$.id" has type number, expected array`},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.assertion(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil)
+
+		assert.EqualError(t, err, testCase.expected)
+	}
+}